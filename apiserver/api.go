@@ -13,6 +13,7 @@ package apiserver
 import (
 	"context"
 	"net/http"
+	"time"
 )
 
 // ConfigurationAPIRouter defines the required methods for binding the api requests to a responses for the ConfigurationAPI
@@ -24,11 +25,21 @@ type ConfigurationAPIRouter interface {
 	GetConfigurationById(http.ResponseWriter, *http.Request)
 	PutConfigurationById(http.ResponseWriter, *http.Request)
 	DeleteConfigurationById(http.ResponseWriter, *http.Request)
+	GetSensorsOfConfiguration(http.ResponseWriter, *http.Request)
+	ImportSensors(http.ResponseWriter, *http.Request)
+	ExportSensors(http.ResponseWriter, *http.Request)
+	GetGroupMappings(http.ResponseWriter, *http.Request)
+	PutGroupMapping(http.ResponseWriter, *http.Request)
+	DeleteGroupMapping(http.ResponseWriter, *http.Request)
 	SensorsGet(http.ResponseWriter, *http.Request)
 	SensorsPost(http.ResponseWriter, *http.Request)
 	SensorsIdGet(http.ResponseWriter, *http.Request)
 	SensorsIdPut(http.ResponseWriter, *http.Request)
 	SensorsIdDelete(http.ResponseWriter, *http.Request)
+	SensorsIdCertificatePinPut(http.ResponseWriter, *http.Request)
+	SensorsIdGeometriesGet(http.ResponseWriter, *http.Request)
+	SensorsIdHeatmapGet(http.ResponseWriter, *http.Request)
+	SensorsTestPost(http.ResponseWriter, *http.Request)
 }
 
 // CustomizationAPIRouter defines the required methods for binding the api requests to a responses for the CustomizationAPI
@@ -38,6 +49,27 @@ type CustomizationAPIRouter interface {
 	GetDashboardTemplateByName(http.ResponseWriter, *http.Request)
 }
 
+// DatapushAPIRouter defines the required methods for binding the api requests to a responses for the DatapushAPI
+// The DatapushAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a DatapushAPIServicer to perform the required actions, then write the service results to the http response.
+type DatapushAPIRouter interface {
+	GetDeadLetters(http.ResponseWriter, *http.Request)
+	GetDeadLetterById(http.ResponseWriter, *http.Request)
+	DeleteDeadLetterById(http.ResponseWriter, *http.Request)
+	ReplayDeadLetter(http.ResponseWriter, *http.Request)
+	GetArchivedDatapushes(http.ResponseWriter, *http.Request)
+	GetArchivedDatapushById(http.ResponseWriter, *http.Request)
+	ReplayArchive(http.ResponseWriter, *http.Request)
+}
+
+// HealthAPIRouter defines the required methods for binding the api requests to a responses for the HealthAPI
+// The HealthAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a HealthAPIServicer to perform the required actions, then write the service results to the http response.
+type HealthAPIRouter interface {
+	GetLiveness(http.ResponseWriter, *http.Request)
+	GetReadiness(http.ResponseWriter, *http.Request)
+}
+
 // VersionAPIRouter defines the required methods for binding the api requests to a responses for the VersionAPI
 // The VersionAPIRouter implementation should parse necessary information from the http request,
 // pass the data to a VersionAPIServicer to perform the required actions, then write the service results to the http response.
@@ -56,11 +88,21 @@ type ConfigurationAPIServicer interface {
 	GetConfigurationById(context.Context, int64) (ImplResponse, error)
 	PutConfigurationById(context.Context, int64, Configuration) (ImplResponse, error)
 	DeleteConfigurationById(context.Context, int64) (ImplResponse, error)
-	SensorsGet(context.Context) (ImplResponse, error)
+	GetSensorsOfConfiguration(context.Context, int64, string, string, string, string, int32, int32, string) (ImplResponse, error)
+	ImportSensors(context.Context, int64, SensorImportRequest) (ImplResponse, error)
+	ExportSensors(context.Context, int64, string) (ImplResponse, error)
+	GetGroupMappings(context.Context, int64) (ImplResponse, error)
+	PutGroupMapping(context.Context, int64, string, GroupMapping) (ImplResponse, error)
+	DeleteGroupMapping(context.Context, int64, string) (ImplResponse, error)
+	SensorsGet(context.Context, string, string, string, string) (ImplResponse, error)
 	SensorsPost(context.Context, SensorCreateUpdate) (ImplResponse, error)
 	SensorsIdGet(context.Context, int32) (ImplResponse, error)
 	SensorsIdPut(context.Context, int32, SensorCreateUpdate) (ImplResponse, error)
 	SensorsIdDelete(context.Context, int32) (ImplResponse, error)
+	SensorsIdCertificatePinPut(context.Context, int32, CertificatePin) (ImplResponse, error)
+	SensorsIdGeometriesGet(context.Context, int32, bool) (ImplResponse, error)
+	SensorsIdHeatmapGet(context.Context, int32, time.Time, time.Time) (ImplResponse, error)
+	SensorsTestPost(context.Context, SensorCreateUpdate) (ImplResponse, error)
 }
 
 // CustomizationAPIServicer defines the api actions for the CustomizationAPI service
@@ -71,6 +113,29 @@ type CustomizationAPIServicer interface {
 	GetDashboardTemplateByName(context.Context, string, string) (ImplResponse, error)
 }
 
+// DatapushAPIServicer defines the api actions for the DatapushAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type DatapushAPIServicer interface {
+	GetDeadLetters(context.Context, string, int32) (ImplResponse, error)
+	GetDeadLetterById(context.Context, int64) (ImplResponse, error)
+	DeleteDeadLetterById(context.Context, int64) (ImplResponse, error)
+	ReplayDeadLetter(context.Context, int64) (ImplResponse, error)
+	GetArchivedDatapushes(context.Context, int64, string, time.Time, time.Time, int32) (ImplResponse, error)
+	GetArchivedDatapushById(context.Context, int64) (ImplResponse, error)
+	ReplayArchive(context.Context, ReplayRequest) (ImplResponse, error)
+}
+
+// HealthAPIServicer defines the api actions for the HealthAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type HealthAPIServicer interface {
+	GetLiveness(context.Context) (ImplResponse, error)
+	GetReadiness(context.Context) (ImplResponse, error)
+}
+
 // VersionAPIServicer defines the api actions for the VersionAPI service
 // This interface intended to stay up to date with the openapi yaml used to generate it,
 // while the service implementation can be ignored with the .openapi-generator-ignore file
@@ -16,6 +16,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 )
@@ -78,6 +79,36 @@ func (c *ConfigurationAPIController) Routes() Routes {
 			"/v1/configs/{config-id}",
 			c.DeleteConfigurationById,
 		},
+		"GetSensorsOfConfiguration": Route{
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/sensors",
+			c.GetSensorsOfConfiguration,
+		},
+		"ImportSensors": Route{
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/sensors/import",
+			c.ImportSensors,
+		},
+		"ExportSensors": Route{
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/sensors/export",
+			c.ExportSensors,
+		},
+		"GetGroupMappings": Route{
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/group-mappings",
+			c.GetGroupMappings,
+		},
+		"PutGroupMapping": Route{
+			strings.ToUpper("Put"),
+			"/v1/configs/{config-id}/group-mappings/{group}",
+			c.PutGroupMapping,
+		},
+		"DeleteGroupMapping": Route{
+			strings.ToUpper("Delete"),
+			"/v1/configs/{config-id}/group-mappings/{group}",
+			c.DeleteGroupMapping,
+		},
 		"SensorsGet": Route{
 			strings.ToUpper("Get"),
 			"/v1/sensors",
@@ -103,6 +134,26 @@ func (c *ConfigurationAPIController) Routes() Routes {
 			"/v1/sensors/{id}",
 			c.SensorsIdDelete,
 		},
+		"SensorsIdCertificatePinPut": Route{
+			strings.ToUpper("Put"),
+			"/v1/sensors/{id}/certificate-pin",
+			c.SensorsIdCertificatePinPut,
+		},
+		"SensorsIdGeometriesGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/sensors/{id}/geometries",
+			c.SensorsIdGeometriesGet,
+		},
+		"SensorsIdHeatmapGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/sensors/{id}/heatmap",
+			c.SensorsIdHeatmapGet,
+		},
+		"SensorsTestPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/sensors/test",
+			c.SensorsTestPost,
+		},
 	}
 }
 
@@ -223,9 +274,299 @@ func (c *ConfigurationAPIController) DeleteConfigurationById(w http.ResponseWrit
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetSensorsOfConfiguration - Get sensors of a configuration
+func (c *ConfigurationAPIController) GetSensorsOfConfiguration(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "config-id", Err: err}, nil)
+		return
+	}
+	var hostnameParam string
+	if query.Has("hostname") {
+		param := query.Get("hostname")
+
+		hostnameParam = param
+	} else {
+	}
+	var macParam string
+	if query.Has("mac") {
+		param := query.Get("mac")
+
+		macParam = param
+	} else {
+	}
+	var statusParam string
+	if query.Has("status") {
+		param := query.Get("status")
+
+		statusParam = param
+	} else {
+	}
+	var discoveryModeParam string
+	if query.Has("discoveryMode") {
+		param := query.Get("discoveryMode")
+
+		discoveryModeParam = param
+	} else {
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+		var param int32 = 1
+		pageParam = param
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](500),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+		var param int32 = 50
+		pageSizeParam = param
+	}
+	var sortParam string
+	if query.Has("sort") {
+		param := query.Get("sort")
+
+		sortParam = param
+	} else {
+		param := "id"
+		sortParam = param
+	}
+	result, err := c.service.GetSensorsOfConfiguration(r.Context(), configIdParam, hostnameParam, macParam, statusParam, discoveryModeParam, pageParam, pageSizeParam, sortParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ImportSensors - Import sensors
+func (c *ConfigurationAPIController) ImportSensors(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "config-id", Err: err}, nil)
+		return
+	}
+	var sensorImportRequestParam SensorImportRequest
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&sensorImportRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertSensorImportRequestRequired(sensorImportRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertSensorImportRequestConstraints(sensorImportRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.ImportSensors(r.Context(), configIdParam, sensorImportRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ExportSensors - Export sensors
+func (c *ConfigurationAPIController) ExportSensors(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "config-id", Err: err}, nil)
+		return
+	}
+	var formatParam string
+	if query.Has("format") {
+		param := query.Get("format")
+
+		formatParam = param
+	} else {
+		param := "json"
+		formatParam = param
+	}
+	result, err := c.service.ExportSensors(r.Context(), configIdParam, formatParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetGroupMappings - Get group mappings
+func (c *ConfigurationAPIController) GetGroupMappings(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "config-id", Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetGroupMappings(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// PutGroupMapping - Creates or updates a group mapping
+func (c *ConfigurationAPIController) PutGroupMapping(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "config-id", Err: err}, nil)
+		return
+	}
+	groupParam := params["group"]
+	if groupParam == "" {
+		c.errorHandler(w, r, &RequiredError{"group"}, nil)
+		return
+	}
+	var groupMappingParam GroupMapping
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&groupMappingParam); err != nil && !errors.Is(err, io.EOF) {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertGroupMappingRequired(groupMappingParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertGroupMappingConstraints(groupMappingParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PutGroupMapping(r.Context(), configIdParam, groupParam, groupMappingParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// DeleteGroupMapping - Deletes a group mapping
+func (c *ConfigurationAPIController) DeleteGroupMapping(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "config-id", Err: err}, nil)
+		return
+	}
+	groupParam := params["group"]
+	if groupParam == "" {
+		c.errorHandler(w, r, &RequiredError{"group"}, nil)
+		return
+	}
+	result, err := c.service.DeleteGroupMapping(r.Context(), configIdParam, groupParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // SensorsGet - Get list of sensors
 func (c *ConfigurationAPIController) SensorsGet(w http.ResponseWriter, r *http.Request) {
-	result, err := c.service.SensorsGet(r.Context())
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var hostnameParam string
+	if query.Has("hostname") {
+		param := query.Get("hostname")
+
+		hostnameParam = param
+	} else {
+	}
+	var macParam string
+	if query.Has("mac") {
+		param := query.Get("mac")
+
+		macParam = param
+	} else {
+	}
+	var statusParam string
+	if query.Has("status") {
+		param := query.Get("status")
+
+		statusParam = param
+	} else {
+	}
+	var discoveryModeParam string
+	if query.Has("discoveryMode") {
+		param := query.Get("discoveryMode")
+
+		discoveryModeParam = param
+	} else {
+	}
+	result, err := c.service.SensorsGet(r.Context(), hostnameParam, macParam, statusParam, discoveryModeParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -339,3 +680,156 @@ func (c *ConfigurationAPIController) SensorsIdDelete(w http.ResponseWriter, r *h
 	// If no error, encode the body and the result code
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
+
+// SensorsIdCertificatePinPut - Pin the certificate of a sensor
+func (c *ConfigurationAPIController) SensorsIdCertificatePinPut(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam, err := parseNumericParameter[int32](
+		params["id"],
+		WithRequire[int32](parseInt32),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "id", Err: err}, nil)
+		return
+	}
+	var certificatePinParam CertificatePin
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&certificatePinParam); err != nil && !errors.Is(err, io.EOF) {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertCertificatePinRequired(certificatePinParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertCertificatePinConstraints(certificatePinParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.SensorsIdCertificatePinPut(r.Context(), idParam, certificatePinParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// SensorsIdGeometriesGet - Get the geometries of a sensor
+func (c *ConfigurationAPIController) SensorsIdGeometriesGet(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	idParam, err := parseNumericParameter[int32](
+		params["id"],
+		WithRequire[int32](parseInt32),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "id", Err: err}, nil)
+		return
+	}
+	var transformedParam bool
+	if query.Has("transformed") {
+		param, err := parseBoolParameter(
+			query.Get("transformed"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "transformed", Err: err}, nil)
+			return
+		}
+
+		transformedParam = param
+	} else {
+		var param bool = false
+		transformedParam = param
+	}
+	result, err := c.service.SensorsIdGeometriesGet(r.Context(), idParam, transformedParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// SensorsIdHeatmapGet - Get the heatmaps of a sensor
+func (c *ConfigurationAPIController) SensorsIdHeatmapGet(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	idParam, err := parseNumericParameter[int32](
+		params["id"],
+		WithRequire[int32](parseInt32),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "id", Err: err}, nil)
+		return
+	}
+	var fromParam time.Time
+	if query.Has("from") {
+		param, err := parseTime(query.Get("from"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "from", Err: err}, nil)
+			return
+		}
+
+		fromParam = param
+	} else {
+	}
+	var toParam time.Time
+	if query.Has("to") {
+		param, err := parseTime(query.Get("to"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "to", Err: err}, nil)
+			return
+		}
+
+		toParam = param
+	} else {
+	}
+	result, err := c.service.SensorsIdHeatmapGet(r.Context(), idParam, fromParam, toParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// SensorsTestPost - Test the connection to a sensor
+func (c *ConfigurationAPIController) SensorsTestPost(w http.ResponseWriter, r *http.Request) {
+	var sensorCreateUpdateParam SensorCreateUpdate
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&sensorCreateUpdateParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertSensorCreateUpdateRequired(sensorCreateUpdateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertSensorCreateUpdateConstraints(sensorCreateUpdateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.SensorsTestPost(r.Context(), sensorCreateUpdateParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
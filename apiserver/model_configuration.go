@@ -16,16 +16,16 @@ type Configuration struct {
 	// Internal identifier for the configured API (created automatically).
 	Id *int64 `json:"id,omitempty"`
 
-	// Specifies whether the device certificate should be verified (should be true for devices publicly accessible, can be false for devices inaccessible from the Internet).
+	// Specifies whether the device certificate should be verified against the system's CAs and the `caBundle`. If false, the certificate of each sensor is pinned on the first contact instead, and later connections fail if the sensor presents another certificate.
 	CheckCertificate bool `json:"checkCertificate,omitempty"`
 
 	// Flag to enable or disable fetching from this API
 	Enable *bool `json:"enable,omitempty"`
 
-	// Interval in seconds for collecting data from API
+	// Interval in seconds for collecting data from API, between 1 and 86400
 	RefreshInterval int32 `json:"refreshInterval,omitempty"`
 
-	// Timeout in seconds
+	// Timeout in seconds, between 1 and 3600
 	RequestTimeout *int32 `json:"requestTimeout,omitempty"`
 
 	// Set to `true` by the app when running and to `false` when app is stopped
@@ -36,6 +36,63 @@ type Configuration struct {
 
 	// ID of the last Eliona user who created or updated the configuration
 	UserId *string `json:"userId,omitempty"`
+
+	// Shape of the asset tree. `flat` places the people counters directly under the root asset, `group` under an asset for their Xovis group and `tag` under an asset for the tag set on the sensor.
+	HierarchyMode string `json:"hierarchyMode,omitempty"`
+
+	// PEM encoded CA certificates the sensor certificates are verified against in addition to the system's CAs, if `checkCertificate` is set.
+	CaBundle *string `json:"caBundle,omitempty"`
+
+	// Proxy the sensors are reached through, `http://`, `https://` (HTTP CONNECT), `socks5://` or `socks5h://` URL with optional credentials. If not set, the sensors are connected directly.
+	ProxyUrl *string `json:"proxyUrl,omitempty"`
+
+	// MQTT broker the sensors push their data to, `tcp://`, `mqtt://`, `ssl://`, `tls://`, `mqtts://`, `ws://` or `wss://` URL. TLS connections verify the broker certificate against the system's CAs and the `caBundle`. If not set, datapushes are only received through the webhook.
+	MqttBrokerUrl *string `json:"mqttBrokerUrl,omitempty"`
+
+	// Topics subscribed at the MQTT broker. MQTT wildcards `+` and `#` are allowed. Required if `mqttBrokerUrl` is set.
+	MqttTopics *[]string `json:"mqttTopics,omitempty"`
+
+	// User name for the MQTT broker
+	MqttUsername *string `json:"mqttUsername,omitempty"`
+
+	// Password for the MQTT broker. It is not returned when reading the configuration. If omitted when updating the configuration, the stored password is kept, an empty password removes it.
+	MqttPassword *string `json:"mqttPassword,omitempty"`
+
+	// If true, the counts of lines and zones are written only from the logics push of the sensors, with the timestamps of their time bins. The collection still creates the assets and updates the people counters.
+	PreferLogicsPush bool `json:"preferLogicsPush,omitempty"`
+
+	// If true, alarm rules are created for the health attributes `online`, `covered` and `tilted` of the people counters, which are updated by the status push of the sensors.
+	HealthAlarms bool `json:"healthAlarms,omitempty"`
+
+	// If true, the tracked objects of the live push are counted per object type on the people counters and their positions in the zones are accumulated in heatmaps.
+	TrackingEnabled bool `json:"trackingEnabled,omitempty"`
+
+	// Edge length of the heatmap cells in meters, between 0.05 and 10. Defaults to 0.5.
+	HeatmapCellSize *float64 `json:"heatmapCellSize,omitempty"`
+
+	// Time window in seconds a heatmap accumulates positions for, between 60 and 86400. Defaults to 900.
+	HeatmapWindow *int32 `json:"heatmapWindow,omitempty"`
+
+	// If true, the raw datapush bodies received from the sensors are archived per sensor and can be replayed.
+	ArchiveEnabled bool `json:"archiveEnabled,omitempty"`
+
+	// Time in seconds archived datapush bodies are kept, between 3600 and 2592000. Defaults to 604800.
+	ArchiveMaxAge *int32 `json:"archiveMaxAge,omitempty"`
+
+	// Maximum size in bytes of the archived datapush bodies per sensor, between 1024 and 1073741824. The oldest bodies are removed first. Defaults to 10485760.
+	ArchiveMaxSize *int64 `json:"archiveMaxSize,omitempty"`
+
+	// If true, the tracked objects of the live push are classified as staff, children, groups and adults, and the lines and zones get separate counts per class.
+	ClassificationEnabled bool `json:"classificationEnabled,omitempty"`
+
+	// Persons shorter than this height in meters are children, between 0.5 and 2.5. Defaults to 1.4.
+	ChildMaxHeight *float64 `json:"childMaxHeight,omitempty"`
+
+	// Minimum number of members of a group, between 2 and 100. Groups with fewer members are not counted. Defaults to 2.
+	GroupMinMembers *int32 `json:"groupMinMembers,omitempty"`
+
+	// Tag of the persons counted as staff, as set up on the sensors. Defaults to staff.
+	StaffTag *string `json:"staffTag,omitempty"`
 }
 
 // AssertConfigurationRequired checks if the required fields are not zero-ed
@@ -10,6 +10,10 @@
 
 package apiserver
 
+import (
+	"time"
+)
+
 type Sensor struct {
 	Id int32 `json:"id,omitempty"`
 
@@ -23,11 +27,47 @@ type Sensor struct {
 
 	Port int32 `json:"port,omitempty"`
 
+	// Hostname or IP address the app connects to instead of `hostname`, e.g. the external address of a NAT. `hostname` keeps the sensor's own address.
+	ConnectHostname *string `json:"connect_hostname,omitempty"`
+
+	// Port the app connects to instead of `port`, e.g. of a port mapping.
+	ConnectPort *int32 `json:"connect_port,omitempty"`
+
 	DiscoveryMode string `json:"discovery_mode,omitempty"`
 
 	L3FirstIp *string `json:"l3_first_ip,omitempty"`
 
 	L3Count *int32 `json:"l3_count,omitempty"`
+
+	// Group the sensor's assets are created in. If not set, the group configured on the device is used.
+	Group *string `json:"group,omitempty"`
+
+	// Eliona projects the sensor's assets are created in. If not set, the projects of the group mapping or of the configuration are used.
+	ProjectIds *[]string `json:"project_ids,omitempty"`
+
+	// ID of an existing Eliona asset, e.g. a building, floor or room, the people counter is placed under. The asset is used in its own project only. If not set, the parent asset of the group mapping is used or the people counter is placed in the xovis group.
+	ParentAssetId *int32 `json:"parent_asset_id,omitempty"`
+
+	// Custom tag grouping the sensor's assets if the hierarchy mode of the configuration is `tag`. Sensors without tag are placed directly under the root asset.
+	Tag *string `json:"tag,omitempty"`
+
+	// Affine transform `[a, b, c, d, e, f]` from the sensor's coordinates to the coordinates of a floor plan, mapping the point x, y to a·x + b·y + c, d·x + e·y + f. If not set, geometries are in the sensor's coordinates.
+	GeometryTransform *[]float64 `json:"geometry_transform,omitempty"`
+
+	// MAC address reported by the sensor.
+	MacAddress *string `json:"mac_address,omitempty"`
+
+	// SHA-256 fingerprint of the sensor's certificate, pinned on the first contact if the configuration does not check certificates. Change it with `/sensors/{id}/certificate-pin`.
+	CertFingerprint *string `json:"cert_fingerprint,omitempty"`
+
+	// Result of the last collection from the sensor.
+	Status string `json:"status,omitempty"`
+
+	// Reason of the last failed collection.
+	StatusMessage *string `json:"status_message,omitempty"`
+
+	// Time of the last successful collection from the sensor.
+	LastSeen *time.Time `json:"last_seen,omitempty"`
 }
 
 // AssertSensorRequired checks if the required fields are not zero-ed
@@ -19,11 +19,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"strconv"
+	"time"
 	"xovis/apiserver"
 	"xovis/broker"
 	"xovis/conf"
+	"xovis/eliona"
 	confmodel "xovis/model/conf"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
 // ConfigurationAPIService is a service that implements the logic for the ConfigurationAPIServicer
@@ -51,6 +57,9 @@ func (s *ConfigurationAPIService) GetConfigurations(ctx context.Context) (apiser
 }
 
 func (s *ConfigurationAPIService) PostConfiguration(ctx context.Context, config apiserver.Configuration) (apiserver.ImplResponse, error) {
+	if err := validateConfiguration(config); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusUnprocessableEntity}, err
+	}
 	appConfig := toAppConfig(config)
 	insertedConfig, err := conf.InsertConfig(ctx, appConfig)
 	if err != nil {
@@ -72,7 +81,19 @@ func (s *ConfigurationAPIService) GetConfigurationById(ctx context.Context, conf
 
 func (s *ConfigurationAPIService) PutConfigurationById(ctx context.Context, configId int64, config apiserver.Configuration) (apiserver.ImplResponse, error) {
 	config.Id = &configId
+	if err := validateConfiguration(config); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusUnprocessableEntity}, err
+	}
 	appConfig := toAppConfig(config)
+	// The MQTT password is not returned, so a configuration sent back as read
+	// keeps the stored one.
+	if appConfig.MQTTPassword == nil {
+		existing, err := conf.GetConfig(ctx, configId)
+		if err != nil && !errors.Is(err, conf.ErrNotFound) {
+			return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+		}
+		appConfig.MQTTPassword = existing.MQTTPassword
+	}
 	upsertedConfig, err := conf.UpsertConfig(ctx, appConfig)
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
@@ -92,8 +113,227 @@ func (s *ConfigurationAPIService) DeleteConfigurationById(ctx context.Context, c
 }
 
 // Sensor methods
-func (s *ConfigurationAPIService) SensorsGet(ctx context.Context) (apiserver.ImplResponse, error) {
-	appSensors, err := conf.GetSensors(ctx)
+func (s *ConfigurationAPIService) ImportSensors(ctx context.Context, configId int64, request apiserver.SensorImportRequest) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+
+	var rows []importRow
+	switch {
+	case request.Csv != nil && request.Sensors != nil:
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, errors.New("either csv or sensors must be set, not both")
+	case request.Csv != nil:
+		rows, err = parseCSVRows(*request.Csv)
+		if err != nil {
+			return apiserver.ImplResponse{Code: http.StatusBadRequest}, err
+		}
+	case request.Sensors != nil:
+		for _, row := range *request.Sensors {
+			rows = append(rows, importRow{row: row})
+		}
+	default:
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, errors.New("either csv or sensors must be set")
+	}
+
+	existingSensors, err := conf.GetSensorsOfConfig(ctx, configId)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	existing := map[string]int64{}
+	for _, sensor := range existingSensors {
+		existing[sensorAddress(sensor)] = sensor.ID
+	}
+
+	result := apiserver.SensorImportResult{
+		DryRun: request.DryRun,
+		Rows:   []apiserver.SensorImportRowResult{},
+	}
+	var sensorsToCreate []confmodel.Sensor
+	var rowsToCreate []int
+	seen := map[string]int32{}
+	for i, row := range rows {
+		rowResult := apiserver.SensorImportRowResult{Row: int32(i + 1)}
+		sensor, errs := validateImportRow(row.row, config)
+		errs = append(row.errors, errs...)
+		rowResult.Hostname = sensor.Hostname
+
+		address := sensorAddress(sensor)
+		if first, ok := seen[address]; ok {
+			errs = append(errs, fmt.Sprintf("same hostname and port as row %d", first))
+		} else {
+			seen[address] = rowResult.Row
+		}
+
+		if id, ok := existing[address]; ok && len(errs) == 0 {
+			rowResult.Status = importStatusExists
+			rowResult.SensorId = &id
+			result.Skipped++
+		} else if len(errs) > 0 {
+			rowResult.Status = importStatusInvalid
+			rowResult.Errors = &errs
+			result.Invalid++
+		} else {
+			rowResult.Status = importStatusValid
+			sensorsToCreate = append(sensorsToCreate, sensor)
+			rowsToCreate = append(rowsToCreate, i)
+		}
+		result.Rows = append(result.Rows, rowResult)
+	}
+
+	if result.Invalid > 0 {
+		return apiserver.Response(http.StatusUnprocessableEntity, result), nil
+	}
+	if request.DryRun {
+		return apiserver.Response(http.StatusOK, result), nil
+	}
+
+	created, err := conf.InsertSensors(ctx, sensorsToCreate)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	for i, sensor := range created {
+		rowResult := &result.Rows[rowsToCreate[i]]
+		rowResult.Status = importStatusCreated
+		rowResult.SensorId = &sensor.ID
+	}
+	result.Created = int32(len(created))
+	return apiserver.Response(http.StatusOK, result), nil
+}
+
+// ExportSensors exports the sensors as JSON. CSV exports are served by the
+// handler of NewSensorExportHandler instead.
+func (s *ConfigurationAPIService) ExportSensors(ctx context.Context, configId int64, format string) (apiserver.ImplResponse, error) {
+	sensors, result, err := sensorsToExport(ctx, configId)
+	if err != nil || result.Code != 0 {
+		return result, err
+	}
+
+	switch format {
+	case "json":
+		rows := []apiserver.SensorImportRow{}
+		for _, sensor := range sensors {
+			rows = append(rows, toImportRow(sensor))
+		}
+		return apiserver.Response(http.StatusOK, rows), nil
+	default:
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, fieldError("format", "unknown format %q, use json or csv", format)
+	}
+}
+
+// sensorsToExport returns the sensors of the configuration, or the response
+// if they cannot be exported.
+func sensorsToExport(ctx context.Context, configID int64) ([]confmodel.Sensor, apiserver.ImplResponse, error) {
+	if _, err := conf.GetConfig(ctx, configID); errors.Is(err, conf.ErrNotFound) {
+		return nil, apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	} else if err != nil {
+		return nil, apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	sensors, err := conf.GetSensorsOfConfig(ctx, configID)
+	if err != nil {
+		return nil, apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return sensors, apiserver.ImplResponse{}, nil
+}
+
+func (s *ConfigurationAPIService) GetGroupMappings(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	if _, err := conf.GetConfig(ctx, configId); errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	} else if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	appMappings, err := conf.GetGroupMappings(ctx, configId)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	mappings := []apiserver.GroupMapping{}
+	for _, appMapping := range appMappings {
+		mappings = append(mappings, toAPIGroupMapping(appMapping))
+	}
+	return apiserver.Response(http.StatusOK, mappings), nil
+}
+
+func (s *ConfigurationAPIService) PutGroupMapping(ctx context.Context, configId int64, group string, mapping apiserver.GroupMapping) (apiserver.ImplResponse, error) {
+	if _, err := conf.GetConfig(ctx, configId); errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	} else if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if err := validateGroupMapping(mapping); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusUnprocessableEntity}, err
+	}
+	if err := checkParentAsset(ctx, mapping.ParentAssetId); err != nil {
+		// The error handler answers a missing asset with 422.
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+
+	appMapping := confmodel.GroupMapping{
+		ConfigID:      configId,
+		Group:         group,
+		ParentAssetID: mapping.ParentAssetId,
+	}
+	if mapping.ProjectIds != nil {
+		appMapping.ProjectIDs = *mapping.ProjectIds
+	}
+	upserted, err := conf.UpsertGroupMapping(ctx, appMapping)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, toAPIGroupMapping(upserted)), nil
+}
+
+func (s *ConfigurationAPIService) DeleteGroupMapping(ctx context.Context, configId int64, group string) (apiserver.ImplResponse, error) {
+	err := conf.DeleteGroupMapping(ctx, configId, group)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.ImplResponse{Code: http.StatusNoContent}, nil
+}
+
+// checkParentAsset verifies that the parent asset exists in Eliona, if set.
+func checkParentAsset(ctx context.Context, assetID *int32) error {
+	if assetID == nil {
+		return nil
+	}
+	_, err := eliona.GetAsset(ctx, *assetID)
+	if errors.Is(err, eliona.ErrAssetNotFound) {
+		return fieldError("parent_asset_id", "asset %v does not exist", *assetID)
+	}
+	return err
+}
+
+func toAPIGroupMapping(appMapping confmodel.GroupMapping) apiserver.GroupMapping {
+	mapping := apiserver.GroupMapping{
+		Group:         appMapping.Group,
+		ParentAssetId: appMapping.ParentAssetID,
+	}
+	if appMapping.ProjectIDs != nil {
+		mapping.ProjectIds = &appMapping.ProjectIDs
+	}
+	return mapping
+}
+
+func sensorAddress(sensor confmodel.Sensor) string {
+	return net.JoinHostPort(sensor.Hostname, strconv.Itoa(int(sensor.Port)))
+}
+
+func (s *ConfigurationAPIService) SensorsGet(ctx context.Context, hostname string, mac string, status string, discoveryMode string) (apiserver.ImplResponse, error) {
+	filter := confmodel.SensorFilter{
+		Hostname:      hostname,
+		MACAddress:    mac,
+		Status:        status,
+		DiscoveryMode: discoveryMode,
+	}
+	if err := validateSensorFilter(filter); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, err
+	}
+	appSensors, _, err := conf.QuerySensors(ctx, filter)
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
@@ -104,18 +344,97 @@ func (s *ConfigurationAPIService) SensorsGet(ctx context.Context) (apiserver.Imp
 	return apiserver.Response(http.StatusOK, sensors), nil
 }
 
+func (s *ConfigurationAPIService) GetSensorsOfConfiguration(ctx context.Context, configId int64, hostname string, mac string, status string, discoveryMode string, page int32, pageSize int32, sort string) (apiserver.ImplResponse, error) {
+	if _, err := conf.GetConfig(ctx, configId); errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	} else if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+
+	filter := confmodel.SensorFilter{
+		ConfigID:      configId,
+		Hostname:      hostname,
+		MACAddress:    mac,
+		Status:        status,
+		DiscoveryMode: discoveryMode,
+		Sort:          sort,
+		Page:          int(page),
+		PageSize:      int(pageSize),
+	}
+	if err := validateSensorFilter(filter); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, err
+	}
+	appSensors, total, err := conf.QuerySensors(ctx, filter)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, fieldError("sort", "cannot sort by %q", sort)
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+
+	sensorPage := apiserver.SensorPage{
+		Items:    []apiserver.Sensor{},
+		Page:     page,
+		PageSize: pageSize,
+		Total:    total,
+	}
+	for _, appSensor := range appSensors {
+		sensorPage.Items = append(sensorPage.Items, toAPISensor(appSensor))
+	}
+	return apiserver.Response(http.StatusOK, sensorPage), nil
+}
+
+func validateSensorFilter(filter confmodel.SensorFilter) error {
+	var errs fieldErrors
+	switch filter.Status {
+	case "", confmodel.SensorStatusUnknown, confmodel.SensorStatusOK, confmodel.SensorStatusError, confmodel.SensorStatusUnsupported, confmodel.SensorStatusSecurity:
+	default:
+		errs.add("status", "unknown status %q", filter.Status)
+	}
+	switch filter.DiscoveryMode {
+	case "", "disabled", "L2", "L3":
+	default:
+		errs.add("discoveryMode", "unknown discovery mode %q", filter.DiscoveryMode)
+	}
+	return errs.err()
+}
+
 func (s *ConfigurationAPIService) SensorsPost(ctx context.Context, sensor apiserver.SensorCreateUpdate) (apiserver.ImplResponse, error) {
 	appSensor := toAppSensor(sensor)
-	insertedSensor, err := conf.InsertSensor(ctx, appSensor)
+	if err := validateSensor(appSensor); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusUnprocessableEntity}, err
+	}
+	appSensor, err := withConfig(ctx, appSensor)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusUnprocessableEntity}, fieldError("configuration_id", "configuration %d not found", sensor.ConfigurationId)
+	}
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
+	if err := checkParentAsset(ctx, appSensor.ParentAssetID); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
 
-	discovered, err := discoverDevices(insertedSensor.Config)
+	// Test and discover before saving, so that unusable sensors are not
+	// stored.
+	report, pin := testSensor(ctx, appSensor)
+	if !report.Success {
+		resp, _ := formatResponse("testing sensor failed, sensor not saved", report)
+		return apiserver.Response(http.StatusBadRequest, resp), nil
+	}
+	if pin != "" {
+		appSensor.CertFingerprint = &pin
+	}
+	discovered, err := discoverDevices(ctx, appSensor)
 	if err != nil {
-		err = fmt.Errorf("testing configuration: %v", err)
+		err = fmt.Errorf("discovering devices: %v", err)
 		return apiserver.ImplResponse{Code: http.StatusBadRequest, Body: err}, err
 	}
+
+	insertedSensor, err := conf.InsertSensor(ctx, appSensor)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
 	resp, err := formatResponse(fmt.Sprintf("configuration successfully created and discovered %v new sensors", discovered), toAPISensor(insertedSensor))
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
@@ -137,16 +456,51 @@ func (s *ConfigurationAPIService) SensorsIdGet(ctx context.Context, sensorId int
 func (s *ConfigurationAPIService) SensorsIdPut(ctx context.Context, sensorId int32, sensor apiserver.SensorCreateUpdate) (apiserver.ImplResponse, error) {
 	sensor.Id = sensorId
 	appSensor := toAppSensor(sensor)
-	upsertedSensor, err := conf.UpsertSensor(ctx, appSensor)
+	if err := validateSensor(appSensor); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusUnprocessableEntity}, err
+	}
+	appSensor, err := withConfig(ctx, appSensor)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusUnprocessableEntity}, fieldError("configuration_id", "configuration %d not found", sensor.ConfigurationId)
+	}
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
+	if err := checkParentAsset(ctx, appSensor.ParentAssetID); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	// The pinned certificate is kept, so that the test checks against it.
+	existing, err := conf.GetSensor(ctx, int64(sensorId))
+	if err != nil && !errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	appSensor.CertFingerprint = existing.CertFingerprint
 
-	discovered, err := discoverDevices(upsertedSensor.Config)
+	report, pin := testSensor(ctx, appSensor)
+	if !report.Success {
+		resp, _ := formatResponse("testing sensor failed, sensor not saved", report)
+		return apiserver.Response(http.StatusBadRequest, resp), nil
+	}
+	tested := appSensor
+	if pin != "" {
+		tested.CertFingerprint = &pin
+	}
+	discovered, err := discoverDevices(ctx, tested)
 	if err != nil {
-		err = fmt.Errorf("testing configuration: %v", err)
+		err = fmt.Errorf("discovering devices: %v", err)
 		return apiserver.ImplResponse{Code: http.StatusBadRequest, Body: err}, err
 	}
+
+	upsertedSensor, err := conf.UpsertSensor(ctx, appSensor)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if pin != "" {
+		if err := conf.SetSensorCertFingerprint(ctx, upsertedSensor.ID, &pin); err != nil {
+			return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+		}
+		upsertedSensor.CertFingerprint = &pin
+	}
 	resp, err := formatResponse(fmt.Sprintf("configuration successfully created and discovered %v new sensors", discovered), toAPISensor(upsertedSensor))
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
@@ -165,24 +519,299 @@ func (s *ConfigurationAPIService) SensorsIdDelete(ctx context.Context, sensorId
 	return apiserver.ImplResponse{Code: http.StatusNoContent}, nil
 }
 
+func (s *ConfigurationAPIService) SensorsTestPost(ctx context.Context, sensor apiserver.SensorCreateUpdate) (apiserver.ImplResponse, error) {
+	appSensor := toAppSensor(sensor)
+	if err := validateSensor(appSensor); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusUnprocessableEntity}, err
+	}
+	appSensor, err := withConfig(ctx, appSensor)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusUnprocessableEntity}, fieldError("configuration_id", "configuration %d not found", sensor.ConfigurationId)
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	report, _ := testSensor(ctx, appSensor)
+	return apiserver.Response(http.StatusOK, report), nil
+}
+
+// SensorsIdCertificatePinPut pins the given fingerprint or, if none is given,
+// the certificate the sensor presents now. Use it after replacing a sensor or
+// its certificate.
+func (s *ConfigurationAPIService) SensorsIdCertificatePinPut(ctx context.Context, sensorId int32, pin apiserver.CertificatePin) (apiserver.ImplResponse, error) {
+	sensor, err := conf.GetSensor(ctx, int64(sensorId))
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+
+	var fingerprint string
+	if pin.Fingerprint != nil {
+		fingerprint, err = broker.NormalizeFingerprint(*pin.Fingerprint)
+		if err != nil {
+			return apiserver.ImplResponse{Code: http.StatusUnprocessableEntity}, fieldError("fingerprint", "%v", err)
+		}
+	} else {
+		fingerprint, err = broker.NewXovisConnector(sensor).CertificateFingerprint(ctx)
+		if err != nil {
+			return apiserver.ImplResponse{Code: http.StatusBadGateway}, fmt.Errorf("reading certificate of sensor %d: %v", sensorId, err)
+		}
+	}
+
+	if err := conf.SetSensorCertFingerprint(ctx, sensor.ID, &fingerprint); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	log.Info("services", "pinned certificate %s of sensor %d (%s)", fingerprint, sensor.ID, sensor.Hostname)
+	// The mismatch is resolved, the next collection shows whether the sensor works.
+	if sensor.Status == confmodel.SensorStatusSecurity {
+		if err := conf.SetSensorStatus(ctx, sensor.ID, confmodel.SensorStatusUnknown, ""); err != nil {
+			return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+		}
+	}
+	return apiserver.Response(http.StatusOK, apiserver.CertificatePin{Fingerprint: &fingerprint}), nil
+}
+
+// SensorsIdGeometriesGet - Get the geometries of a sensor
+func (s *ConfigurationAPIService) SensorsIdGeometriesGet(ctx context.Context, sensorId int32, transformed bool) (apiserver.ImplResponse, error) {
+	sensor, err := conf.GetSensor(ctx, int64(sensorId))
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	var transform confmodel.AffineTransform
+	if transformed {
+		if sensor.GeometryTransform == nil {
+			return apiserver.ImplResponse{Code: http.StatusBadRequest}, fieldError("transformed", "sensor %d has no geometry_transform", sensorId)
+		}
+		transform = sensor.GeometryTransform
+	}
+
+	collection := apiserver.GeometryFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: []apiserver.GeometryFeature{},
+	}
+	// The geometries are known by the sensor's MAC address, which is recorded
+	// on the first collection.
+	if sensor.MACAddress == nil {
+		return apiserver.Response(http.StatusOK, collection), nil
+	}
+	geometries, err := conf.GetGeometries(ctx, *sensor.MACAddress)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	for _, geometry := range geometries {
+		collection.Features = append(collection.Features, toAPIGeometryFeature(geometry, transform))
+	}
+	return apiserver.Response(http.StatusOK, collection), nil
+}
+
+// defaultHeatmapRange is the time range of the heatmaps returned if the
+// request does not set it.
+const defaultHeatmapRange = 24 * time.Hour
+
+// SensorsIdHeatmapGet - Get the heatmaps of a sensor
+func (s *ConfigurationAPIService) SensorsIdHeatmapGet(ctx context.Context, sensorId int32, from time.Time, to time.Time) (apiserver.ImplResponse, error) {
+	sensor, err := conf.GetSensor(ctx, int64(sensorId))
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultHeatmapRange)
+	}
+	if !from.Before(to) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, fieldError("from", "must be before to")
+	}
+
+	// The sensor's pushes refer to it by its MAC address, which is known after
+	// the first collection.
+	heatmaps := []apiserver.Heatmap{}
+	if sensor.MACAddress == nil {
+		return apiserver.Response(http.StatusOK, heatmaps), nil
+	}
+	appHeatmaps, err := conf.GetHeatmaps(ctx, *sensor.MACAddress, from, to)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	for _, heatmap := range appHeatmaps {
+		heatmaps = append(heatmaps, toAPIHeatmap(heatmap))
+	}
+	return apiserver.Response(http.StatusOK, heatmaps), nil
+}
+
+// withConfig completes the sensor with its configuration, which defines how to
+// connect to the sensor.
+func withConfig(ctx context.Context, sensor confmodel.Sensor) (confmodel.Sensor, error) {
+	config, err := conf.GetConfig(ctx, sensor.Config.ID)
+	if err != nil {
+		return confmodel.Sensor{}, err
+	}
+	sensor.Config = config
+	return sensor, nil
+}
+
+// testSensor returns the test report and the fingerprint of the sensor's
+// certificate if it is to be pinned.
+func testSensor(ctx context.Context, sensor confmodel.Sensor) (apiserver.SensorTestReport, string) {
+	diagnosis := broker.NewXovisConnector(sensor).Diagnose(ctx)
+	report := apiserver.SensorTestReport{
+		Success: diagnosis.OK(),
+		Checks:  []apiserver.SensorTestCheck{},
+	}
+	for _, check := range diagnosis.Checks {
+		apiCheck := apiserver.SensorTestCheck{
+			Name:   check.Name,
+			Status: check.Status,
+		}
+		if check.Message != "" {
+			apiCheck.Message = &check.Message
+		}
+		report.Checks = append(report.Checks, apiCheck)
+	}
+	if diagnosis.Device != nil {
+		report.Device = &apiserver.SensorTestDevice{
+			Mac:      diagnosis.Device.MAC,
+			Model:    diagnosis.Device.Model,
+			Firmware: diagnosis.Device.FWVersion,
+			Name:     diagnosis.Device.Name,
+			Group:    diagnosis.Device.Group,
+		}
+	}
+	if diagnosis.Fingerprint != "" {
+		report.CertFingerprint = &diagnosis.Fingerprint
+	}
+	return report, diagnosis.Pin
+}
+
 // Conversion functions
 func toAPIConfig(appConfig confmodel.Configuration) apiserver.Configuration {
 	return apiserver.Configuration{
-		Id:               &appConfig.ID,
-		CheckCertificate: appConfig.CheckCertificate,
-		Enable:           &appConfig.Enable,
-		RefreshInterval:  appConfig.RefreshInterval,
-		RequestTimeout:   &appConfig.RequestTimeout,
-		Active:           &appConfig.Active,
-		ProjectIDs:       &appConfig.ProjectIDs,
-		UserId:           &appConfig.UserId,
+		Id:                    &appConfig.ID,
+		CheckCertificate:      appConfig.CheckCertificate,
+		Enable:                &appConfig.Enable,
+		RefreshInterval:       appConfig.RefreshInterval,
+		RequestTimeout:        &appConfig.RequestTimeout,
+		Active:                &appConfig.Active,
+		ProjectIDs:            &appConfig.ProjectIDs,
+		UserId:                &appConfig.UserId,
+		HierarchyMode:         appConfig.HierarchyMode,
+		CaBundle:              appConfig.CABundle,
+		ProxyUrl:              appConfig.ProxyURL,
+		MqttBrokerUrl:         appConfig.MQTTBrokerURL,
+		MqttTopics:            &appConfig.MQTTTopics,
+		MqttUsername:          appConfig.MQTTUsername,
+		PreferLogicsPush:      appConfig.PreferLogicsPush,
+		HealthAlarms:          appConfig.HealthAlarms,
+		TrackingEnabled:       appConfig.TrackingEnabled,
+		HeatmapCellSize:       &appConfig.HeatmapCellSize,
+		HeatmapWindow:         &appConfig.HeatmapWindow,
+		ArchiveEnabled:        appConfig.ArchiveEnabled,
+		ArchiveMaxAge:         &appConfig.ArchiveMaxAge,
+		ArchiveMaxSize:        &appConfig.ArchiveMaxSize,
+		ClassificationEnabled: appConfig.ClassificationEnabled,
+		ChildMaxHeight:        &appConfig.ChildMaxHeight,
+		GroupMinMembers:       &appConfig.GroupMinMembers,
+		StaffTag:              &appConfig.StaffTag,
+	}
+}
+
+func toAPIGeometryFeature(appGeometry confmodel.Geometry, transform confmodel.AffineTransform) apiserver.GeometryFeature {
+	feature := apiserver.GeometryFeature{
+		Type: "Feature",
+		Properties: apiserver.GeometryProperties{
+			LogicId:    appGeometry.LogicID,
+			GeometryId: appGeometry.GeometryID,
+			Name:       appGeometry.Name,
+			Type:       appGeometry.Type,
+			UpdatedAt:  appGeometry.UpdatedAt,
+		},
+	}
+	if geoJSON := appGeometry.GeoJSON(transform); geoJSON != nil {
+		feature.Geometry = &apiserver.GeoJsonGeometry{
+			Type:        geoJSON.Type,
+			Coordinates: geoJSON.Coordinates,
+		}
+	}
+	return feature
+}
+
+func toAPIHeatmap(appHeatmap confmodel.Heatmap) apiserver.Heatmap {
+	cells := make([][]int64, 0, appHeatmap.Rows)
+	for row := range int(appHeatmap.Rows) {
+		start := row * int(appHeatmap.Columns)
+		cells = append(cells, appHeatmap.Cells[start:start+int(appHeatmap.Columns)])
+	}
+	return apiserver.Heatmap{
+		GeometryId:   appHeatmap.GeometryID,
+		GeometryName: appHeatmap.GeometryName,
+		From:         appHeatmap.WindowStart,
+		To:           appHeatmap.WindowEnd,
+		OriginX:      appHeatmap.OriginX,
+		OriginY:      appHeatmap.OriginY,
+		CellSize:     appHeatmap.CellSize,
+		Frames:       appHeatmap.Frames,
+		Cells:        cells,
 	}
 }
 
 func toAppConfig(apiConfig apiserver.Configuration) confmodel.Configuration {
 	appConfig := confmodel.Configuration{
-		CheckCertificate: apiConfig.CheckCertificate,
-		RefreshInterval:  apiConfig.RefreshInterval,
+		CheckCertificate:      apiConfig.CheckCertificate,
+		RefreshInterval:       apiConfig.RefreshInterval,
+		HierarchyMode:         apiConfig.HierarchyMode,
+		CABundle:              apiConfig.CaBundle,
+		ProxyURL:              apiConfig.ProxyUrl,
+		MQTTBrokerURL:         apiConfig.MqttBrokerUrl,
+		MQTTUsername:          apiConfig.MqttUsername,
+		MQTTPassword:          apiConfig.MqttPassword,
+		PreferLogicsPush:      apiConfig.PreferLogicsPush,
+		HealthAlarms:          apiConfig.HealthAlarms,
+		TrackingEnabled:       apiConfig.TrackingEnabled,
+		HeatmapCellSize:       confmodel.DefaultHeatmapCellSize,
+		HeatmapWindow:         confmodel.DefaultHeatmapWindow,
+		ArchiveEnabled:        apiConfig.ArchiveEnabled,
+		ArchiveMaxAge:         confmodel.DefaultArchiveMaxAge,
+		ArchiveMaxSize:        confmodel.DefaultArchiveMaxSize,
+		ClassificationEnabled: apiConfig.ClassificationEnabled,
+		ChildMaxHeight:        confmodel.DefaultChildMaxHeight,
+		GroupMinMembers:       confmodel.DefaultGroupMinMembers,
+		StaffTag:              confmodel.DefaultStaffTag,
+	}
+	if apiConfig.HeatmapCellSize != nil {
+		appConfig.HeatmapCellSize = *apiConfig.HeatmapCellSize
+	}
+	if apiConfig.HeatmapWindow != nil {
+		appConfig.HeatmapWindow = *apiConfig.HeatmapWindow
+	}
+	if apiConfig.ArchiveMaxAge != nil {
+		appConfig.ArchiveMaxAge = *apiConfig.ArchiveMaxAge
+	}
+	if apiConfig.ArchiveMaxSize != nil {
+		appConfig.ArchiveMaxSize = *apiConfig.ArchiveMaxSize
+	}
+	if apiConfig.ChildMaxHeight != nil {
+		appConfig.ChildMaxHeight = *apiConfig.ChildMaxHeight
+	}
+	if apiConfig.GroupMinMembers != nil {
+		appConfig.GroupMinMembers = *apiConfig.GroupMinMembers
+	}
+	if apiConfig.StaffTag != nil {
+		appConfig.StaffTag = *apiConfig.StaffTag
+	}
+	if apiConfig.MqttTopics != nil {
+		appConfig.MQTTTopics = *apiConfig.MqttTopics
+	}
+	if appConfig.HierarchyMode == "" {
+		appConfig.HierarchyMode = confmodel.HierarchyGroup
 	}
 	if apiConfig.Id != nil {
 		appConfig.ID = *apiConfig.Id
@@ -203,50 +832,70 @@ func toAppConfig(apiConfig apiserver.Configuration) confmodel.Configuration {
 }
 
 func toAPISensor(appSensor confmodel.Sensor) apiserver.Sensor {
-	return apiserver.Sensor{
+	apiSensor := apiserver.Sensor{
 		Id:              int32(appSensor.ID),
 		ConfigurationId: int32(appSensor.Config.ID),
 		Username:        appSensor.Username,
 		Password:        appSensor.Password,
 		Hostname:        appSensor.Hostname,
 		Port:            appSensor.Port,
+		ConnectHostname: appSensor.ConnectHostname,
+		ConnectPort:     appSensor.ConnectPort,
 		DiscoveryMode:   appSensor.DiscoveryMode,
 		L3FirstIp:       appSensor.L3FirstIP,
 		L3Count:         appSensor.L3Count,
-	}
+		Group:           appSensor.Group,
+		ParentAssetId:   appSensor.ParentAssetID,
+		Tag:             appSensor.Tag,
+		MacAddress:      appSensor.MACAddress,
+		CertFingerprint: appSensor.CertFingerprint,
+		Status:          appSensor.Status,
+		StatusMessage:   appSensor.StatusMessage,
+		LastSeen:        appSensor.LastSeen,
+	}
+	if appSensor.ProjectIDs != nil {
+		apiSensor.ProjectIds = &appSensor.ProjectIDs
+	}
+	if appSensor.GeometryTransform != nil {
+		transform := []float64(appSensor.GeometryTransform)
+		apiSensor.GeometryTransform = &transform
+	}
+	return apiSensor
 }
 
 func toAppSensor(apiSensor apiserver.SensorCreateUpdate) confmodel.Sensor {
-	return confmodel.Sensor{
-		ID:            int64(apiSensor.Id),
-		Config:        confmodel.Configuration{ID: int64(apiSensor.ConfigurationId)},
-		Username:      apiSensor.Username,
-		Password:      apiSensor.Password,
-		Hostname:      apiSensor.Hostname,
-		Port:          apiSensor.Port,
-		DiscoveryMode: apiSensor.DiscoveryMode,
-		L3FirstIP:     apiSensor.L3FirstIp,
-		L3Count:       apiSensor.L3Count,
-	}
+	appSensor := confmodel.Sensor{
+		ID:              int64(apiSensor.Id),
+		Config:          confmodel.Configuration{ID: int64(apiSensor.ConfigurationId)},
+		Username:        apiSensor.Username,
+		Password:        apiSensor.Password,
+		Hostname:        apiSensor.Hostname,
+		Port:            apiSensor.Port,
+		ConnectHostname: apiSensor.ConnectHostname,
+		ConnectPort:     apiSensor.ConnectPort,
+		DiscoveryMode:   apiSensor.DiscoveryMode,
+		L3FirstIP:       apiSensor.L3FirstIp,
+		L3Count:         apiSensor.L3Count,
+		Group:           apiSensor.Group,
+		ParentAssetID:   apiSensor.ParentAssetId,
+		Tag:             apiSensor.Tag,
+	}
+	if apiSensor.ProjectIds != nil {
+		appSensor.ProjectIDs = *apiSensor.ProjectIds
+	}
+	if apiSensor.GeometryTransform != nil {
+		appSensor.GeometryTransform = *apiSensor.GeometryTransform
+	}
+	return appSensor
 }
 
-func discoverDevices(config confmodel.Configuration) (int, error) {
-	sensors, err := conf.GetSensorsOfConfig(context.Background(), config.ID)
+func discoverDevices(ctx context.Context, sensor confmodel.Sensor) (int, error) {
+	xovis := broker.NewXovisConnector(sensor)
+	discovereds, err := xovis.DiscoverDevices(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("Couldn't read sensors from DB: %v", err)
+		return 0, fmt.Errorf("discovering devices: %v", err)
 	}
-
-	discoveredSensors := 0
-	for _, sensor := range sensors {
-		xovis := broker.NewXovisConnector(sensor)
-		discovereds, err := xovis.DiscoverDevices()
-		if err != nil {
-			return 0, fmt.Errorf("discovering devices: %v", err)
-		}
-		discoveredSensors += len(discovereds)
-	}
-
-	return discoveredSensors, nil
+	return len(discovereds), nil
 }
 
 // formatResponse marshals the struct and appends it to the text in a nicely formatted way.
@@ -0,0 +1,208 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+	"xovis/apiserver"
+	"xovis/conf"
+	"xovis/datapush"
+	confmodel "xovis/model/conf"
+)
+
+// DatapushAPIService is a service that implements the logic for the DatapushAPIServicer
+// This service should implement the business logic for every endpoint for the DatapushAPI API.
+// Include any external packages or services that will be required by this service.
+type DatapushAPIService struct {
+}
+
+// NewDatapushAPIService creates a default api service
+func NewDatapushAPIService() apiserver.DatapushAPIServicer {
+	return &DatapushAPIService{}
+}
+
+// GetDeadLetters - List dead letters
+func (s *DatapushAPIService) GetDeadLetters(ctx context.Context, serialNumber string, limit int32) (apiserver.ImplResponse, error) {
+	appDeadLetters, err := conf.GetDeadLetters(ctx, serialNumber, int(limit))
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	deadLetters := make([]apiserver.DeadLetter, 0, len(appDeadLetters))
+	for _, deadLetter := range appDeadLetters {
+		deadLetters = append(deadLetters, toAPIDeadLetter(deadLetter))
+	}
+	return apiserver.Response(http.StatusOK, deadLetters), nil
+}
+
+// GetDeadLetterById - Get a dead letter
+func (s *DatapushAPIService) GetDeadLetterById(ctx context.Context, id int64) (apiserver.ImplResponse, error) {
+	appDeadLetter, err := conf.GetDeadLetter(ctx, id)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	deadLetter := toAPIDeadLetter(appDeadLetter)
+	if err := json.Unmarshal(appDeadLetter.Payload, &deadLetter.Payload); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("decoding payload of dead letter %d: %v", id, err)
+	}
+	return apiserver.Response(http.StatusOK, deadLetter), nil
+}
+
+// DeleteDeadLetterById - Delete a dead letter
+func (s *DatapushAPIService) DeleteDeadLetterById(ctx context.Context, id int64) (apiserver.ImplResponse, error) {
+	err := conf.DeleteDeadLetter(ctx, id)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.ImplResponse{Code: http.StatusNoContent}, nil
+}
+
+// ReplayDeadLetter - Replay a dead letter
+func (s *DatapushAPIService) ReplayDeadLetter(ctx context.Context, id int64) (apiserver.ImplResponse, error) {
+	err := conf.ReplayDeadLetter(ctx, id)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.ImplResponse{Code: http.StatusAccepted}, nil
+}
+
+// GetArchivedDatapushes - List archived datapushes
+func (s *DatapushAPIService) GetArchivedDatapushes(ctx context.Context, configId int64, serialNumber string, from time.Time, to time.Time, limit int32) (apiserver.ImplResponse, error) {
+	appItems, err := conf.GetArchivedDatapushes(ctx, confmodel.ArchiveFilter{
+		ConfigurationID: configId,
+		SerialNumber:    serialNumber,
+		From:            from,
+		To:              to,
+		Limit:           int(limit),
+	}, false)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	items := make([]apiserver.ArchivedDatapush, 0, len(appItems))
+	for _, item := range appItems {
+		items = append(items, toAPIArchivedDatapush(item))
+	}
+	return apiserver.Response(http.StatusOK, items), nil
+}
+
+// GetArchivedDatapushById - Get an archived datapush
+func (s *DatapushAPIService) GetArchivedDatapushById(ctx context.Context, id int64) (apiserver.ImplResponse, error) {
+	appItem, err := conf.GetArchivedDatapush(ctx, id)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	item := toAPIArchivedDatapush(appItem)
+	body := string(appItem.Body)
+	item.Body = &body
+	return apiserver.Response(http.StatusOK, item), nil
+}
+
+// ReplayArchive - Replay archived datapushes
+func (s *DatapushAPIService) ReplayArchive(ctx context.Context, request apiserver.ReplayRequest) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, request.ConfigId)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusUnprocessableEntity}, fieldError("configId", "configuration %d does not exist", request.ConfigId)
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	filter := confmodel.ArchiveFilter{
+		SerialNumber: request.SerialNumber,
+	}
+	if request.From != nil {
+		filter.From = *request.From
+	}
+	if request.To != nil {
+		filter.To = *request.To
+	}
+	if request.From != nil && request.To != nil && !filter.From.Before(filter.To) {
+		return apiserver.ImplResponse{Code: http.StatusUnprocessableEntity}, fieldError("from", "must be before to")
+	}
+	if request.Ids != nil {
+		filter.IDs = *request.Ids
+	}
+
+	result, err := datapush.ReplayArchive(ctx, config, filter, request.DryRun)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, toAPIReplayResult(result, request.DryRun)), nil
+}
+
+func toAPIArchivedDatapush(item confmodel.ArchivedDatapush) apiserver.ArchivedDatapush {
+	return apiserver.ArchivedDatapush{
+		Id:           item.ID,
+		ConfigId:     item.ConfigurationID,
+		Source:       item.Source,
+		SerialNumber: item.SerialNumber,
+		ContentType:  item.ContentType,
+		Size:         item.Size,
+		ReceivedAt:   item.ReceivedAt,
+	}
+}
+
+func toAPIReplayResult(result datapush.ReplayResult, dryRun bool) apiserver.ReplayResult {
+	apiResult := apiserver.ReplayResult{
+		Packages: int32(result.Packages),
+		Failed:   int32(result.Failed),
+		Errors:   result.Errors,
+	}
+	if dryRun {
+		upserts := make([]apiserver.AssetUpsert, 0, len(result.Upserts))
+		for _, upsert := range result.Upserts {
+			apiUpsert := apiserver.AssetUpsert{
+				AssetId: upsert.AssetID,
+				Gai:     upsert.GAI,
+				Data:    upsert.Data,
+			}
+			if !upsert.Timestamp.IsZero() {
+				apiUpsert.Timestamp = &upsert.Timestamp
+			}
+			upserts = append(upserts, apiUpsert)
+		}
+		apiResult.Upserts = &upserts
+	}
+	return apiResult
+}
+
+func toAPIDeadLetter(deadLetter confmodel.DeadLetter) apiserver.DeadLetter {
+	return apiserver.DeadLetter{
+		Id:           deadLetter.ID,
+		ConfigId:     deadLetter.ConfigurationID,
+		Source:       deadLetter.Source,
+		SerialNumber: deadLetter.SerialNumber,
+		Attempts:     deadLetter.Attempts,
+		LastError:    deadLetter.LastError,
+		ReceivedAt:   deadLetter.ReceivedAt,
+		FailedAt:     deadLetter.FailedAt,
+	}
+}
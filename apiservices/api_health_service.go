@@ -0,0 +1,129 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+	"xovis/apiserver"
+	"xovis/conf"
+	"xovis/eliona"
+	confmodel "xovis/model/conf"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+const (
+	healthOK      = "ok"
+	healthFailing = "failing"
+
+	// healthCheckTimeout limits each dependency check, so that probes answer in time.
+	healthCheckTimeout = 5 * time.Second
+
+	// minCollectionAge is the lowest age of the last successful collection
+	// considered stale, so that short refresh intervals do not cause flapping.
+	minCollectionAge = 5 * time.Minute
+)
+
+// CollectorState reports the state of the collectors running per configuration.
+type CollectorState interface {
+	LastSuccess(configID int64, task string) (time.Time, bool)
+}
+
+// HealthAPIService is a service that implements the logic for the HealthAPIServicer
+// This service should implement the business logic for every endpoint for the HealthAPI API.
+// Include any external packages or services that will be required by this service.
+type HealthAPIService struct {
+	collectors     CollectorState
+	collectionTask string
+}
+
+// NewHealthAPIService creates a default api service. The readiness reports the
+// age of the last success of collectionTask for each enabled configuration.
+func NewHealthAPIService(collectors CollectorState, collectionTask string) apiserver.HealthAPIServicer {
+	return &HealthAPIService{
+		collectors:     collectors,
+		collectionTask: collectionTask,
+	}
+}
+
+// GetLiveness - Liveness of the app
+func (s *HealthAPIService) GetLiveness(ctx context.Context) (apiserver.ImplResponse, error) {
+	return apiserver.Response(http.StatusOK, apiserver.HealthStatus{Status: healthOK}), nil
+}
+
+// GetReadiness - Readiness of the app
+func (s *HealthAPIService) GetReadiness(ctx context.Context) (apiserver.ImplResponse, error) {
+	checks := []apiserver.HealthCheck{
+		runHealthCheck(ctx, "database", conf.Ping),
+		runHealthCheck(ctx, "eliona-api", eliona.Ping),
+	}
+
+	configs, err := conf.GetConfigs(ctx)
+	if err != nil {
+		checks = append(checks, failingCheck("collectors", fmt.Sprintf("reading configs: %v", err)))
+	}
+	for _, config := range configs {
+		if !config.Enable {
+			continue
+		}
+		checks = append(checks, s.collectorCheck(config))
+	}
+
+	status := apiserver.HealthStatus{Status: healthOK, Checks: &checks}
+	for _, check := range checks {
+		if check.Status != healthOK {
+			log.Warn("services", "Readiness check %s failing: %s", check.Name, *check.Message)
+			status.Status = healthFailing
+		}
+	}
+	if status.Status != healthOK {
+		return apiserver.Response(http.StatusServiceUnavailable, status), nil
+	}
+	return apiserver.Response(http.StatusOK, status), nil
+}
+
+func (s *HealthAPIService) collectorCheck(config confmodel.Configuration) apiserver.HealthCheck {
+	name := fmt.Sprintf("collector-config-%d", config.ID)
+	lastSuccess, running := s.collectors.LastSuccess(config.ID, s.collectionTask)
+	if !running {
+		// The collector is started by the next reconciliation.
+		return apiserver.HealthCheck{Name: name, Status: healthOK, Message: common.Ptr("not started yet")}
+	}
+
+	age := time.Since(lastSuccess).Truncate(time.Second)
+	maxAge := max(3*time.Duration(config.RefreshInterval)*time.Second, minCollectionAge)
+	if age > maxAge {
+		return failingCheck(name, fmt.Sprintf("no successful collection for %v (limit %v)", age, maxAge))
+	}
+	return apiserver.HealthCheck{Name: name, Status: healthOK, Message: common.Ptr(fmt.Sprintf("last successful collection %v ago", age))}
+}
+
+func runHealthCheck(ctx context.Context, name string, check func(ctx context.Context) error) apiserver.HealthCheck {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	if err := check(ctx); err != nil {
+		return failingCheck(name, err.Error())
+	}
+	return apiserver.HealthCheck{Name: name, Status: healthOK}
+}
+
+func failingCheck(name string, message string) apiserver.HealthCheck {
+	return apiserver.HealthCheck{Name: name, Status: healthFailing, Message: &message}
+}
@@ -0,0 +1,67 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"errors"
+	"net/http"
+	"xovis/apiserver"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// ErrorHandler writes errors of the API as ErrorResponse. Invalid requests get
+// field-level errors, internal errors are logged and not exposed to the client.
+func ErrorHandler(w http.ResponseWriter, r *http.Request, err error, result *apiserver.ImplResponse) {
+	code := http.StatusInternalServerError
+	if result != nil && result.Code != 0 {
+		code = result.Code
+	}
+	resp := apiserver.ErrorResponse{
+		Message: err.Error(),
+		Errors:  []apiserver.FieldError{},
+	}
+
+	var parsingErr *apiserver.ParsingError
+	var requiredErr *apiserver.RequiredError
+	var validationErr *ValidationError
+	switch {
+	case errors.As(err, &parsingErr):
+		code = http.StatusBadRequest
+		resp.Message = "invalid request"
+		if parsingErr.Param != "" {
+			resp.Errors = append(resp.Errors, apiserver.FieldError{Field: parsingErr.Param, Message: parsingErr.Err.Error()})
+		} else {
+			resp.Message = "invalid request: " + parsingErr.Error()
+		}
+	case errors.As(err, &requiredErr):
+		code = http.StatusUnprocessableEntity
+		resp.Message = "invalid request"
+		resp.Errors = append(resp.Errors, apiserver.FieldError{Field: requiredErr.Field, Message: "is required"})
+	case errors.As(err, &validationErr):
+		// Invalid parameters are bad requests, invalid bodies cannot be processed.
+		if code != http.StatusBadRequest {
+			code = http.StatusUnprocessableEntity
+		}
+		resp.Message = "invalid request"
+		resp.Errors = append(resp.Errors, validationErr.Fields...)
+	case code >= http.StatusInternalServerError:
+		log.Error("services", "%s %s: %v", r.Method, r.URL.Path, err)
+		resp.Message = http.StatusText(code)
+	}
+
+	_ = apiserver.EncodeJSONResponse(resp, &code, w)
+}
@@ -0,0 +1,292 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"xovis/apiserver"
+	confmodel "xovis/model/conf"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+const (
+	importStatusValid   = "valid"
+	importStatusCreated = "created"
+	importStatusExists  = "exists"
+	importStatusInvalid = "invalid"
+
+	defaultSensorPort = 443
+)
+
+// csvColumns are the columns of the CSV import and export in the order of the export.
+var csvColumns = []string{"hostname", "port", "username", "password", "discovery_mode", "l3_first_ip", "l3_count", "group", "project_ids", "parent_asset_id", "tag", "connect_hostname", "connect_port"}
+
+var requiredCSVColumns = []string{"hostname", "username", "password"}
+
+// importRow is a row of the import together with the errors found while reading it.
+type importRow struct {
+	row    apiserver.SensorImportRow
+	errors []string
+}
+
+// parseCSVRows reads the CSV document of the import. Values which cannot be
+// parsed are reported as errors of the row, while an unreadable document or
+// unknown columns fail the whole import.
+func parseCSVRows(document string) ([]importRow, error) {
+	reader := csv.NewReader(strings.NewReader(document))
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV header: %v", err)
+	}
+
+	columns := map[string]int{}
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !slices.Contains(csvColumns, column) {
+			return nil, fmt.Errorf("unknown CSV column %q, allowed are %s", column, strings.Join(csvColumns, ", "))
+		}
+		columns[column] = i
+	}
+	for _, column := range requiredCSVColumns {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("missing CSV column %q", column)
+		}
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading CSV: %v", err)
+		}
+		rows = append(rows, csvRecordToRow(record, columns))
+	}
+	return rows, nil
+}
+
+func csvRecordToRow(record []string, columns map[string]int) importRow {
+	value := func(column string) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	var result importRow
+	optional := func(column string) *string {
+		if v := value(column); v != "" {
+			return &v
+		}
+		return nil
+	}
+	optionalInt := func(column string) *int32 {
+		v := value(column)
+		if v == "" {
+			return nil
+		}
+		i, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			result.errors = append(result.errors, fmt.Sprintf("%s %q is not a number", column, v))
+			return nil
+		}
+		return common.Ptr(int32(i))
+	}
+
+	result.row = apiserver.SensorImportRow{
+		Hostname:        value("hostname"),
+		Port:            optionalInt("port"),
+		Username:        value("username"),
+		Password:        value("password"),
+		DiscoveryMode:   optional("discovery_mode"),
+		L3FirstIp:       optional("l3_first_ip"),
+		L3Count:         optionalInt("l3_count"),
+		Group:           optional("group"),
+		ParentAssetId:   optionalInt("parent_asset_id"),
+		Tag:             optional("tag"),
+		ConnectHostname: optional("connect_hostname"),
+		ConnectPort:     optionalInt("connect_port"),
+	}
+	if projectIDs := value("project_ids"); projectIDs != "" {
+		ids := splitProjectIDs(projectIDs)
+		result.row.ProjectIds = &ids
+	}
+	return result
+}
+
+func splitProjectIDs(value string) []string {
+	var ids []string
+	for _, id := range strings.Split(value, ";") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// validateImportRow checks the row and converts it to a sensor of the configuration.
+func validateImportRow(row apiserver.SensorImportRow, config confmodel.Configuration) (confmodel.Sensor, []string) {
+	sensor := confmodel.Sensor{
+		Config:          config,
+		Username:        row.Username,
+		Password:        row.Password,
+		Hostname:        strings.TrimSpace(row.Hostname),
+		Port:            defaultSensorPort,
+		DiscoveryMode:   "disabled",
+		L3FirstIP:       row.L3FirstIp,
+		L3Count:         row.L3Count,
+		Group:           row.Group,
+		ParentAssetID:   row.ParentAssetId,
+		Tag:             row.Tag,
+		ConnectHostname: row.ConnectHostname,
+		ConnectPort:     row.ConnectPort,
+	}
+	if row.Port != nil {
+		sensor.Port = *row.Port
+	}
+	if row.DiscoveryMode != nil {
+		sensor.DiscoveryMode = *row.DiscoveryMode
+	}
+	if row.ProjectIds != nil {
+		sensor.ProjectIDs = *row.ProjectIds
+	}
+	return sensor, validateSensorFields(sensor).strings()
+}
+
+func toImportRow(sensor confmodel.Sensor) apiserver.SensorImportRow {
+	row := apiserver.SensorImportRow{
+		Hostname:        sensor.Hostname,
+		Port:            &sensor.Port,
+		Username:        sensor.Username,
+		Password:        sensor.Password,
+		DiscoveryMode:   &sensor.DiscoveryMode,
+		L3FirstIp:       sensor.L3FirstIP,
+		L3Count:         sensor.L3Count,
+		Group:           sensor.Group,
+		ParentAssetId:   sensor.ParentAssetID,
+		Tag:             sensor.Tag,
+		ConnectHostname: sensor.ConnectHostname,
+		ConnectPort:     sensor.ConnectPort,
+	}
+	if sensor.ProjectIDs != nil {
+		row.ProjectIds = &sensor.ProjectIDs
+	}
+	return row
+}
+
+// NewSensorExportHandler serves the CSV export of the sensors of a
+// configuration, which the generated router would encode as JSON, with its
+// content type and file name. Exports in other formats are passed to next.
+func NewSensorExportHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("format") != "csv" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		configID, err := strconv.ParseInt(r.PathValue("configID"), 10, 64)
+		if err != nil {
+			ErrorHandler(w, r, &apiserver.ParsingError{Param: "config-id", Err: err}, nil)
+			return
+		}
+		sensors, result, err := sensorsToExport(r.Context(), configID)
+		if err != nil {
+			ErrorHandler(w, r, err, &result)
+			return
+		}
+		if result.Code != 0 {
+			w.WriteHeader(result.Code)
+			return
+		}
+		data, err := writeCSVExport(sensors)
+		if err != nil {
+			ErrorHandler(w, r, err, nil)
+			return
+		}
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="sensors-%d.csv"`, configID))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(data)
+	})
+}
+
+// writeCSVExport returns the sensors in the CSV format of the import.
+func writeCSVExport(sensors []confmodel.Sensor) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(csvColumns); err != nil {
+		return nil, err
+	}
+	for _, sensor := range sensors {
+		row := toImportRow(sensor)
+		record := []string{
+			row.Hostname,
+			strconv.Itoa(int(*row.Port)),
+			row.Username,
+			row.Password,
+			*row.DiscoveryMode,
+			derefString(row.L3FirstIp),
+			"",
+			derefString(row.Group),
+			"",
+			"",
+			derefString(row.Tag),
+			derefString(row.ConnectHostname),
+			"",
+		}
+		if row.L3Count != nil {
+			record[6] = strconv.Itoa(int(*row.L3Count))
+		}
+		if row.ProjectIds != nil {
+			record[8] = strings.Join(*row.ProjectIds, ";")
+		}
+		if row.ParentAssetId != nil {
+			record[9] = strconv.Itoa(int(*row.ParentAssetId))
+		}
+		if row.ConnectPort != nil {
+			record[12] = strconv.Itoa(int(*row.ConnectPort))
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,54 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSensorExportHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		target     string
+		wantNext   bool
+		wantStatus int
+	}{
+		{name: "JSON export", target: "/v1/configs/1/sensors/export?format=json", wantNext: true, wantStatus: http.StatusOK},
+		{name: "unknown format", target: "/v1/configs/1/sensors/export?format=xml", wantNext: true, wantStatus: http.StatusOK},
+		{name: "CSV export of invalid config ID", target: "/v1/configs/abc/sensors/export?format=csv", wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calledNext := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calledNext = true
+			})
+			mux := http.NewServeMux()
+			mux.Handle("GET /v1/configs/{configID}/sensors/export", NewSensorExportHandler(next))
+
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.target, nil))
+			if calledNext != tt.wantNext {
+				t.Errorf("next called = %v, want %v", calledNext, tt.wantNext)
+			}
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
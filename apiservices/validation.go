@@ -0,0 +1,273 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"regexp"
+	"strings"
+	"xovis/apiserver"
+	"xovis/broker"
+	confmodel "xovis/model/conf"
+	"xovis/mqtt"
+)
+
+const (
+	maxRefreshInterval = 24 * 60 * 60
+	maxRequestTimeout  = 60 * 60
+	maxL3Count         = 65535
+
+	minHeatmapCellSize = 0.05
+	maxHeatmapCellSize = 10
+	minHeatmapWindow   = 60
+	maxHeatmapWindow   = 24 * 60 * 60
+
+	minArchiveMaxAge  = 60 * 60
+	maxArchiveMaxAge  = 30 * 24 * 60 * 60
+	minArchiveMaxSize = 1 << 10
+	maxArchiveMaxSize = 1 << 30
+
+	minChildMaxHeight  = 0.5
+	maxChildMaxHeight  = 2.5
+	minGroupMinMembers = 2
+	maxGroupMinMembers = 100
+)
+
+var hostnameLabel = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// ValidationError lists the fields of a request that are not valid.
+type ValidationError struct {
+	Fields []apiserver.FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		msgs = append(msgs, field.Field+" "+field.Message)
+	}
+	return "invalid request: " + strings.Join(msgs, "; ")
+}
+
+// fieldError returns a ValidationError for a single field.
+func fieldError(field string, format string, args ...any) error {
+	var errs fieldErrors
+	errs.add(field, format, args...)
+	return errs.err()
+}
+
+type fieldErrors []apiserver.FieldError
+
+func (f *fieldErrors) add(field string, format string, args ...any) {
+	*f = append(*f, apiserver.FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (f fieldErrors) err() error {
+	if len(f) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: f}
+}
+
+// strings returns the errors as "<field> <message>".
+func (f fieldErrors) strings() []string {
+	var out []string
+	for _, field := range f {
+		out = append(out, field.Field+" "+field.Message)
+	}
+	return out
+}
+
+func validateConfiguration(config apiserver.Configuration) error {
+	var errs fieldErrors
+	if config.RefreshInterval < 1 || config.RefreshInterval > maxRefreshInterval {
+		errs.add("refreshInterval", "must be between 1 and %d seconds", maxRefreshInterval)
+	}
+	if config.RequestTimeout != nil && (*config.RequestTimeout < 1 || *config.RequestTimeout > maxRequestTimeout) {
+		errs.add("requestTimeout", "must be between 1 and %d seconds", maxRequestTimeout)
+	}
+	if config.ProjectIDs == nil || len(*config.ProjectIDs) == 0 {
+		errs.add("projectIDs", "must contain at least one project ID")
+	} else {
+		validateProjectIDs(&errs, "projectIDs", *config.ProjectIDs)
+	}
+	switch config.HierarchyMode {
+	case "", confmodel.HierarchyFlat, confmodel.HierarchyGroup, confmodel.HierarchyTag:
+	default:
+		errs.add("hierarchyMode", "%q must be one of flat, group, tag", config.HierarchyMode)
+	}
+	if config.ProxyUrl != nil && *config.ProxyUrl != "" {
+		if _, err := broker.ParseProxyURL(*config.ProxyUrl); err != nil {
+			errs.add("proxyUrl", "%v", err)
+		}
+	}
+	if config.CaBundle != nil && *config.CaBundle != "" {
+		if err := broker.ValidateCABundle(*config.CaBundle); err != nil {
+			errs.add("caBundle", "%v", err)
+		}
+	}
+	if config.MqttBrokerUrl != nil && *config.MqttBrokerUrl != "" {
+		if _, err := mqtt.ParseBrokerURL(*config.MqttBrokerUrl); err != nil {
+			errs.add("mqttBrokerUrl", "%v", err)
+		}
+		if config.MqttTopics == nil || len(*config.MqttTopics) == 0 {
+			errs.add("mqttTopics", "must contain at least one topic if mqttBrokerUrl is set")
+		}
+	}
+	if config.MqttTopics != nil {
+		for _, topic := range *config.MqttTopics {
+			if err := mqtt.ValidateTopic(topic); err != nil {
+				errs.add("mqttTopics", "%v", err)
+			}
+		}
+	}
+	if config.HeatmapCellSize != nil && (*config.HeatmapCellSize < minHeatmapCellSize || *config.HeatmapCellSize > maxHeatmapCellSize) {
+		errs.add("heatmapCellSize", "must be between %v and %v meters", minHeatmapCellSize, maxHeatmapCellSize)
+	}
+	if config.HeatmapWindow != nil && (*config.HeatmapWindow < minHeatmapWindow || *config.HeatmapWindow > maxHeatmapWindow) {
+		errs.add("heatmapWindow", "must be between %d and %d seconds", minHeatmapWindow, maxHeatmapWindow)
+	}
+	if config.ArchiveMaxAge != nil && (*config.ArchiveMaxAge < minArchiveMaxAge || *config.ArchiveMaxAge > maxArchiveMaxAge) {
+		errs.add("archiveMaxAge", "must be between %d and %d seconds", minArchiveMaxAge, maxArchiveMaxAge)
+	}
+	if config.ArchiveMaxSize != nil && (*config.ArchiveMaxSize < minArchiveMaxSize || *config.ArchiveMaxSize > maxArchiveMaxSize) {
+		errs.add("archiveMaxSize", "must be between %d and %d bytes", minArchiveMaxSize, maxArchiveMaxSize)
+	}
+	if config.ChildMaxHeight != nil && (*config.ChildMaxHeight < minChildMaxHeight || *config.ChildMaxHeight > maxChildMaxHeight) {
+		errs.add("childMaxHeight", "must be between %v and %v meters", minChildMaxHeight, maxChildMaxHeight)
+	}
+	if config.GroupMinMembers != nil && (*config.GroupMinMembers < minGroupMinMembers || *config.GroupMinMembers > maxGroupMinMembers) {
+		errs.add("groupMinMembers", "must be between %d and %d", minGroupMinMembers, maxGroupMinMembers)
+	}
+	if config.StaffTag != nil && strings.TrimSpace(*config.StaffTag) == "" {
+		errs.add("staffTag", "must not be empty")
+	}
+	return errs.err()
+}
+
+func validateSensor(sensor confmodel.Sensor) error {
+	return validateSensorFields(sensor).err()
+}
+
+// validateSensorFields checks the fields of a sensor, independent of where the
+// sensor comes from.
+func validateSensorFields(sensor confmodel.Sensor) fieldErrors {
+	var errs fieldErrors
+	if sensor.Hostname == "" {
+		errs.add("hostname", "is required")
+	} else if !validHost(sensor.Hostname) {
+		errs.add("hostname", "%q is not a valid host name or IP address", sensor.Hostname)
+	}
+	if sensor.Port < 1 || sensor.Port > 65535 {
+		errs.add("port", "must be between 1 and 65535")
+	}
+	if sensor.ConnectHostname != nil && !validHost(*sensor.ConnectHostname) {
+		errs.add("connect_hostname", "%q is not a valid host name or IP address", *sensor.ConnectHostname)
+	}
+	if sensor.ConnectPort != nil && (*sensor.ConnectPort < 1 || *sensor.ConnectPort > 65535) {
+		errs.add("connect_port", "must be between 1 and 65535")
+	}
+	if sensor.Username == "" {
+		errs.add("username", "is required")
+	}
+	if sensor.Password == "" {
+		errs.add("password", "is required")
+	}
+	switch sensor.DiscoveryMode {
+	case "disabled", "L2":
+	case "L3":
+		if sensor.L3FirstIP == nil || net.ParseIP(*sensor.L3FirstIP) == nil {
+			errs.add("l3_first_ip", "must be a valid IP address for discovery mode L3")
+		}
+		if sensor.L3Count == nil || *sensor.L3Count < 1 || *sensor.L3Count > maxL3Count {
+			errs.add("l3_count", "must be between 1 and %d for discovery mode L3", maxL3Count)
+		}
+	default:
+		errs.add("discovery_mode", "%q must be one of disabled, L2, L3", sensor.DiscoveryMode)
+	}
+	if sensor.Group != nil && strings.TrimSpace(*sensor.Group) == "" {
+		errs.add("group", "must not be empty if set")
+	}
+	if sensor.Tag != nil && strings.TrimSpace(*sensor.Tag) == "" {
+		errs.add("tag", "must not be empty if set")
+	}
+	if sensor.ProjectIDs != nil {
+		validateProjectIDs(&errs, "project_ids", sensor.ProjectIDs)
+	}
+	if sensor.ParentAssetID != nil && *sensor.ParentAssetID < 1 {
+		errs.add("parent_asset_id", "must be a positive asset ID")
+	}
+	if sensor.GeometryTransform != nil {
+		validateAffineTransform(&errs, "geometry_transform", sensor.GeometryTransform)
+	}
+	return errs
+}
+
+// validateAffineTransform checks that the transform has six finite
+// coefficients and can be inverted, so it does not collapse the geometries.
+func validateAffineTransform(errs *fieldErrors, field string, transform confmodel.AffineTransform) {
+	if len(transform) != 6 {
+		errs.add(field, "must have 6 coefficients a, b, c, d, e, f")
+		return
+	}
+	for _, coefficient := range transform {
+		if math.IsNaN(coefficient) || math.IsInf(coefficient, 0) {
+			errs.add(field, "must have finite coefficients")
+			return
+		}
+	}
+	if transform[0]*transform[4]-transform[1]*transform[3] == 0 {
+		errs.add(field, "must be invertible, a·e - b·d is 0")
+	}
+}
+
+func validateGroupMapping(mapping apiserver.GroupMapping) error {
+	var errs fieldErrors
+	if mapping.ProjectIds != nil {
+		validateProjectIDs(&errs, "project_ids", *mapping.ProjectIds)
+	}
+	if mapping.ParentAssetId != nil && *mapping.ParentAssetId < 1 {
+		errs.add("parent_asset_id", "must be a positive asset ID")
+	}
+	return errs.err()
+}
+
+func validateProjectIDs(errs *fieldErrors, field string, ids []string) {
+	for _, id := range ids {
+		if strings.TrimSpace(id) == "" {
+			errs.add(field, "must not contain empty IDs")
+			return
+		}
+	}
+}
+
+// validHost reports whether host is an IP address or a host name as of RFC 1123.
+func validHost(host string) bool {
+	if net.ParseIP(host) != nil {
+		return true
+	}
+	host = strings.TrimSuffix(host, ".")
+	if host == "" || len(host) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(host, ".") {
+		if !hostnameLabel.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
@@ -17,19 +17,28 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strconv"
 	"sync"
 	"time"
 	"xovis/apiserver"
 	"xovis/apiservices"
 	"xovis/broker"
 	"xovis/conf"
+	"xovis/datapush"
 	"xovis/eliona"
+	"xovis/metrics"
 	assetmodel "xovis/model/asset"
 	confmodel "xovis/model/conf"
+	"xovis/mqtt"
+	"xovis/scheduler"
 	"xovis/webhook"
 
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-eliona/app"
 	"github.com/eliona-smart-building-assistant/go-eliona/asset"
 	"github.com/eliona-smart-building-assistant/go-eliona/dashboard"
@@ -57,29 +66,78 @@ func initialization() {
 
 var once sync.Once
 
-func collectData() {
-	configs, err := conf.GetConfigs(context.Background())
+// reconcileInterval is the fallback for noticing configuration changes made
+// directly in the database. Changes made through the API are applied immediately.
+const reconcileInterval = 10 * time.Second
+
+const collectionTask = "collection"
+
+func newCollectors() *scheduler.Scheduler {
+	return scheduler.New(
+		scheduler.Task{
+			Name:     "discovery",
+			Interval: discoveryInterval,
+			Run:      runDiscovery,
+		},
+		scheduler.Task{
+			Name:     collectionTask,
+			Interval: refreshInterval,
+			Run:      runCollection,
+		},
+		scheduler.Task{
+			Name:     "mqtt",
+			Interval: mqttRetryInterval,
+			Run:      runMQTT,
+		},
+	)
+}
+
+func collectData(ctx context.Context, collectors *scheduler.Scheduler) {
+	defer collectors.Stop()
+
+	changed := map[int64]bool{}
+	for {
+		// Changes are kept until the configs could be read.
+		if reconcileCollectors(ctx, collectors, changed) {
+			changed = map[int64]bool{}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case configID := <-conf.Changes():
+			changed[configID] = true
+		case <-time.After(reconcileInterval):
+		}
+	}
+}
+
+// reconcileCollectors runs the collectors of the enabled configs and reports
+// whether the configs could be read. Otherwise it is retried on the next tick.
+func reconcileCollectors(ctx context.Context, collectors *scheduler.Scheduler, changed map[int64]bool) bool {
+	configs, err := conf.GetConfigs(ctx)
 	if err != nil {
-		log.Fatal("conf", "Couldn't read configs from DB: %v", err)
-		return
+		if ctx.Err() == nil {
+			log.Error("conf", "Couldn't read configs from DB: %v", err)
+		}
+		return false
 	}
 	if len(configs) == 0 {
 		once.Do(func() {
 			log.Info("conf", "No configs in DB. Please configure the app in Eliona.")
 		})
-		return
 	}
 
 	for _, config := range configs {
 		if !config.Enable {
 			if config.Active {
-				conf.SetConfigActiveState(context.Background(), config, false)
+				conf.SetConfigActiveState(ctx, config, false)
 			}
 			continue
 		}
 
 		if !config.Active {
-			conf.SetConfigActiveState(context.Background(), config, true)
+			conf.SetConfigActiveState(ctx, config, true)
 			log.Info("conf", "Collecting initialized with Configuration %d:\n"+
 				"Enable: %t\n"+
 				"Refresh Interval: %d\n"+
@@ -91,32 +149,59 @@ func collectData() {
 				config.RequestTimeout,
 				config.ProjectIDs)
 		}
+	}
 
-		common.RunOnceWithParam(func(config confmodel.Configuration) {
-			log.Info("main", "Discovering %d started.", config.ID)
-			discovered, err := discoverDevices(config)
-			if err != nil {
-				return // Error is handled in the method itself.
-			}
-			log.Info("main", "Discovered %d devices for config %d.", discovered, config.ID)
+	collectors.Reconcile(ctx, configs, changed)
+	return true
+}
 
-			time.Sleep(time.Second * 100 * time.Duration(config.RefreshInterval))
-		}, config, fmt.Sprintf("discovery %d", config.ID))
+func refreshInterval(config confmodel.Configuration) time.Duration {
+	return time.Second * time.Duration(config.RefreshInterval)
+}
 
-		common.RunOnceWithParam(func(config confmodel.Configuration) {
-			log.Info("main", "Collecting %d started.", config.ID)
-			if err := collectResources(config); err != nil {
-				return // Error is handled in the method itself.
-			}
-			log.Info("main", "Collecting %d finished.", config.ID)
+func discoveryInterval(config confmodel.Configuration) time.Duration {
+	return time.Second * 100 * time.Duration(config.RefreshInterval)
+}
+
+// mqttRetryInterval is the delay before connecting again after the first
+// connection to the MQTT broker failed. Lost connections are reconnected by the
+// MQTT client itself.
+func mqttRetryInterval(config confmodel.Configuration) time.Duration {
+	return 30 * time.Second
+}
 
-			time.Sleep(time.Second * time.Duration(config.RefreshInterval))
-		}, config, config.ID)
+func runDiscovery(ctx context.Context, config confmodel.Configuration) error {
+	log.Info("main", "Discovering %d started.", config.ID)
+	discovered, err := discoverDevices(ctx, config)
+	if err != nil {
+		return err // Error is logged in the method itself.
 	}
+	log.Info("main", "Discovered %d devices for config %d.", discovered, config.ID)
+	return nil
 }
 
-func discoverDevices(config confmodel.Configuration) (int, error) {
-	sensors, err := conf.GetSensorsOfConfig(context.Background(), config.ID)
+func runCollection(ctx context.Context, config confmodel.Configuration) error {
+	log.Info("main", "Collecting %d started.", config.ID)
+	if err := collectResources(ctx, config); err != nil {
+		return err // Error is logged in the method itself.
+	}
+	log.Info("main", "Collecting %d finished.", config.ID)
+	return nil
+}
+
+// runMQTT keeps the subscription at the MQTT broker of the configuration for
+// as long as the configuration's worker runs.
+func runMQTT(ctx context.Context, config confmodel.Configuration) error {
+	if config.MQTTBrokerURL == nil || *config.MQTTBrokerURL == "" {
+		<-ctx.Done()
+		return nil
+	}
+	log.Info("main", "Subscribing %d to MQTT broker.", config.ID)
+	return mqtt.Subscribe(ctx, config) // Error is logged in the method itself.
+}
+
+func discoverDevices(ctx context.Context, config confmodel.Configuration) (int, error) {
+	sensors, err := conf.GetSensorsOfConfig(ctx, config.ID)
 	if err != nil {
 		log.Error("conf", "Couldn't read sensors from DB: %v", err)
 		return 0, err
@@ -124,15 +209,18 @@ func discoverDevices(config confmodel.Configuration) (int, error) {
 
 	discoveredSensors := 0
 	for _, sensor := range sensors {
+		if err := ctx.Err(); err != nil {
+			return discoveredSensors, err
+		}
 		xovis := broker.NewXovisConnector(sensor)
-		discovereds, err := xovis.DiscoverDevices()
+		discovereds, err := xovis.DiscoverDevices(ctx)
 		if err != nil {
 			log.Error("broker", "discovering devices: %v", err)
 			return discoveredSensors, err
 		}
 
 		for _, discovered := range discovereds {
-			if _, err := conf.UpsertSensorDiscovery(context.Background(), discovered); err != nil {
+			if _, err := conf.UpsertSensorDiscovery(ctx, discovered); err != nil {
 				log.Error("conf", "upserting discovered sensor %+v: %v", discovered, err)
 				return discoveredSensors, err
 			}
@@ -143,65 +231,281 @@ func discoverDevices(config confmodel.Configuration) (int, error) {
 	return discoveredSensors, nil
 }
 
-func collectResources(config confmodel.Configuration) error {
-	sensors, err := conf.GetSensorsOfConfig(context.Background(), config.ID)
+func collectResources(ctx context.Context, config confmodel.Configuration) error {
+	sensors, err := conf.GetSensorsOfConfig(ctx, config.ID)
 	if err != nil {
 		log.Error("conf", "Couldn't read sensors from DB: %v", err)
 		return err
 	}
-
-	root := assetmodel.Root{
-		Groups: map[string]assetmodel.Group{},
-		Config: &config,
+	mappings, err := groupMappings(ctx, config.ID)
+	if err != nil {
+		log.Error("conf", "Couldn't read group mappings from DB: %v", err)
+		return err
 	}
+
+	// A failing sensor is recorded in its status and does not stop collecting
+	// from the other sensors.
+	var devices []collectedDevice
+	var lastErr error
 	for _, sensor := range sensors {
-		xovis := broker.NewXovisConnector(sensor)
-		peopleCounter, err := xovis.GetDevice()
-		if err != nil {
-			log.Error("broker", "getting peopleCounter: %v", err)
+		if err := ctx.Err(); err != nil {
 			return err
 		}
-		peopleCounter.Lines, peopleCounter.Zones, err = xovis.GetAllCounters()
+		peopleCounter, err := collectDevice(ctx, sensor)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
 		if err != nil {
-			log.Error("broker", "getting all counters: %v", err)
-			return err
+			log.Error("broker", "collecting sensor %d (%s): %v", sensor.ID, sensor.Hostname, err)
+			status := confmodel.SensorStatusError
+			var mismatchErr *broker.CertificateMismatchError
+			if errors.Is(err, broker.ErrUnsupportedFirmware) {
+				status = confmodel.SensorStatusUnsupported
+			} else if errors.As(err, &mismatchErr) {
+				status = confmodel.SensorStatusSecurity
+			}
+			setSensorStatus(ctx, sensor, status, err.Error())
+			lastErr = err
+			continue
 		}
+		setSensorStatus(ctx, sensor, confmodel.SensorStatusOK, "")
+		mapping := mappings[peopleCounter.Group]
+		devices = append(devices, collectedDevice{
+			peopleCounter: peopleCounter,
+			projectIDs:    sensor.AssetProjectIDs(mapping),
+			parentAssetID: sensor.AssetParentID(mapping),
+			tag:           sensor.Tag,
+		})
+	}
+	if len(devices) == 0 && lastErr != nil {
+		return fmt.Errorf("no sensor could be collected, last error: %v", lastErr)
+	}
+	parents := parentAssets(ctx, devices)
 
-		groupName := peopleCounter.Group
-		group, ok := root.Groups[groupName]
-		if !ok {
-			group = assetmodel.Group{
-				Name:    groupName,
-				Sensors: []assetmodel.PeopleCounter{},
-				Config:  &config,
-			}
+	for _, projectID := range projectsOf(devices) {
+		if err := ctx.Err(); err != nil {
+			return err
 		}
+		// Sensors can override the projects, so every project gets its own asset tree.
+		projectConfig := config
+		projectConfig.ProjectIDs = []string{projectID}
+		root := assetTree(projectConfig, devices, parents)
+		if err := eliona.CreateAssetsAndUpsertData(ctx, projectConfig, &root); err != nil {
+			log.Error("eliona", "creating assets: %v", err)
+			return err
+		}
+	}
 
-		group.Sensors = append(group.Sensors, peopleCounter)
-		root.Groups[groupName] = group
+	return nil
+}
+
+func collectDevice(ctx context.Context, sensor confmodel.Sensor) (assetmodel.PeopleCounter, error) {
+	xovis := broker.NewXovisConnector(sensor)
+	peopleCounter, err := xovis.GetDevice(ctx)
+	if err != nil {
+		return assetmodel.PeopleCounter{}, fmt.Errorf("getting peopleCounter: %w", err)
+	}
+	// Trust on first use: the certificate of the first contact is pinned.
+	if fingerprint := xovis.FingerprintToPin(); fingerprint != "" {
+		if err := conf.SetSensorCertFingerprint(ctx, sensor.ID, &fingerprint); err != nil {
+			return assetmodel.PeopleCounter{}, fmt.Errorf("pinning certificate: %v", err)
+		}
+		log.Info("broker", "pinned certificate %s of sensor %d (%s)", fingerprint, sensor.ID, sensor.Hostname)
 	}
+	// The MAC address is the serial number datapushes and heatmaps refer to.
+	if sensor.MACAddress == nil && peopleCounter.MAC != "" {
+		if err := conf.SetSensorMACAddress(ctx, sensor.ID, peopleCounter.MAC); err != nil {
+			log.Warn("conf", "recording MAC address of sensor %d: %v", sensor.ID, err)
+		}
+	}
+	peopleCounter.Lines, peopleCounter.Zones, err = xovis.GetAllCounters(ctx)
+	if err != nil {
+		return assetmodel.PeopleCounter{}, fmt.Errorf("getting all counters: %w", err)
+	}
+	// Missing geometries do not stop the collection of the counts.
+	if err := applyGeometries(ctx, sensor, &peopleCounter); err != nil {
+		log.Error("conf", "geometries of sensor %d (%s): %v", sensor.ID, sensor.Hostname, err)
+	}
+	if sensor.Group != nil {
+		peopleCounter.Group = *sensor.Group
+	}
+	return peopleCounter, nil
+}
 
-	if err := eliona.CreateAssetsAndUpsertData(config, &root); err != nil {
-		log.Error("eliona", "creating assets: %v", err)
+// applyGeometries stores the geometries of the counter's logics and sets the
+// GeoJSON of their points, transformed for the sensor, on its lines and zones.
+func applyGeometries(ctx context.Context, sensor confmodel.Sensor, peopleCounter *assetmodel.PeopleCounter) error {
+	var polled []confmodel.Geometry
+	for _, line := range peopleCounter.Lines {
+		polled = append(polled, line.Geometries...)
+	}
+	for _, zone := range peopleCounter.Zones {
+		polled = append(polled, zone.Geometries...)
+	}
+	if err := conf.ReplaceLogicGeometries(ctx, peopleCounter.Serial, polled); err != nil {
 		return err
 	}
-
+	stored, err := conf.GetGeometries(ctx, peopleCounter.Serial)
+	if err != nil {
+		return err
+	}
+	byLogic := map[int32][]confmodel.Geometry{}
+	for _, geometry := range stored {
+		byLogic[geometry.LogicID] = append(byLogic[geometry.LogicID], geometry)
+	}
+	for i := range peopleCounter.Lines {
+		line := &peopleCounter.Lines[i]
+		if line.Geometry, err = geoJSONAttribute(byLogic[int32(line.ID)], sensor.GeometryTransform); err != nil {
+			return err
+		}
+	}
+	for i := range peopleCounter.Zones {
+		zone := &peopleCounter.Zones[i]
+		if zone.Geometry, err = geoJSONAttribute(byLogic[int32(zone.ID)], sensor.GeometryTransform); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func listenApi() {
+func geoJSONAttribute(geometries []confmodel.Geometry, transform confmodel.AffineTransform) (*string, error) {
+	geoJSON := confmodel.LogicGeoJSON(geometries, transform)
+	if geoJSON == nil {
+		return nil, nil
+	}
+	encoded, err := json.Marshal(geoJSON)
+	if err != nil {
+		return nil, fmt.Errorf("encoding GeoJSON: %v", err)
+	}
+	return common.Ptr(string(encoded)), nil
+}
+
+func setSensorStatus(ctx context.Context, sensor confmodel.Sensor, status string, message string) {
+	if err := conf.SetSensorStatus(ctx, sensor.ID, status, message); err != nil {
+		log.Error("conf", "%v", err)
+	}
+}
+
+type collectedDevice struct {
+	peopleCounter assetmodel.PeopleCounter
+	projectIDs    []string
+	parentAssetID *int32
+	tag           *string
+}
+
+func groupMappings(ctx context.Context, configID int64) (map[string]*confmodel.GroupMapping, error) {
+	mappings, err := conf.GetGroupMappings(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	byGroup := make(map[string]*confmodel.GroupMapping, len(mappings))
+	for i := range mappings {
+		byGroup[mappings[i].Group] = &mappings[i]
+	}
+	return byGroup, nil
+}
+
+// parentAssets reads the existing assets the devices are placed under. Devices
+// with a parent that cannot be read stay in their group.
+func parentAssets(ctx context.Context, devices []collectedDevice) map[int32]api.Asset {
+	parents := map[int32]api.Asset{}
+	for _, device := range devices {
+		if device.parentAssetID == nil {
+			continue
+		}
+		id := *device.parentAssetID
+		if _, ok := parents[id]; ok {
+			continue
+		}
+		parent, err := eliona.GetAsset(ctx, id)
+		if err != nil {
+			log.Warn("eliona", "Cannot place sensor %s under asset %v: %v", device.peopleCounter.MAC, id, err)
+			continue
+		}
+		parents[id] = parent
+	}
+	return parents
+}
+
+func projectsOf(devices []collectedDevice) []string {
+	var projectIDs []string
+	seen := map[string]bool{}
+	for _, device := range devices {
+		for _, projectID := range device.projectIDs {
+			if !seen[projectID] {
+				seen[projectID] = true
+				projectIDs = append(projectIDs, projectID)
+			}
+		}
+	}
+	return projectIDs
+}
+
+// assetTree builds the tree of the devices belonging to the single project of config,
+// shaped by the hierarchy mode of config. Devices with a parent asset of this
+// project are placed under it. The devices keep their order in the tree.
+func assetTree(config confmodel.Configuration, devices []collectedDevice, parents map[int32]api.Asset) assetmodel.Root {
+	root := assetmodel.Root{
+		Groups: map[string]assetmodel.Group{},
+		Config: &config,
+	}
+	for _, device := range devices {
+		if !slices.Contains(device.projectIDs, config.ProjectIDs[0]) {
+			continue
+		}
+		var parent *api.Asset
+		if device.parentAssetID != nil {
+			if p, ok := parents[*device.parentAssetID]; ok && p.ProjectId == config.ProjectIDs[0] {
+				parent = &p
+			}
+		}
+		root.AddSensor(device.peopleCounter, device.tag, parent)
+	}
+	return root
+}
+
+// defaultDatapushWorkers is the number of workers processing queued datapushes
+// unless DATAPUSH_WORKERS is set.
+const defaultDatapushWorkers = 4
+
+func processDatapushes(ctx context.Context) {
+	workers := defaultDatapushWorkers
+	if value := common.Getenv("DATAPUSH_WORKERS", ""); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			log.Warn("main", "Invalid DATAPUSH_WORKERS %q, using %d workers.", value, defaultDatapushWorkers)
+		} else {
+			workers = parsed
+		}
+	}
+	datapush.RunWorkers(ctx, workers)
+}
+
+// shutdownTimeout bounds draining the API and datapush requests on termination,
+// staying below the usual 30 s grace period of Kubernetes.
+const shutdownTimeout = 20 * time.Second
+
+func listenApi(ctx context.Context, collectors apiservices.CollectorState) {
 	mux := http.NewServeMux()
 
 	// Add API Server routes
 	apiRouter := apiserver.NewRouter(
-		apiserver.NewConfigurationAPIController(apiservices.NewConfigurationAPIService()),
-		apiserver.NewVersionAPIController(apiservices.NewVersionAPIService()),
-		apiserver.NewCustomizationAPIController(apiservices.NewCustomizationAPIService()),
+		apiserver.NewConfigurationAPIController(apiservices.NewConfigurationAPIService(), apiserver.WithConfigurationAPIErrorHandler(apiservices.ErrorHandler)),
+		apiserver.NewVersionAPIController(apiservices.NewVersionAPIService(), apiserver.WithVersionAPIErrorHandler(apiservices.ErrorHandler)),
+		apiserver.NewCustomizationAPIController(apiservices.NewCustomizationAPIService(), apiserver.WithCustomizationAPIErrorHandler(apiservices.ErrorHandler)),
+		apiserver.NewDatapushAPIController(apiservices.NewDatapushAPIService(), apiserver.WithDatapushAPIErrorHandler(apiservices.ErrorHandler)),
+		apiserver.NewHealthAPIController(apiservices.NewHealthAPIService(collectors, collectionTask), apiserver.WithHealthAPIErrorHandler(apiservices.ErrorHandler)),
 	)
 	mux.Handle("/", apiRouter)
+	// The generated router encodes every response as JSON, the CSV export is served beside it.
+	mux.Handle("GET /v1/configs/{configID}/sensors/export", apiservices.NewSensorExportHandler(apiRouter))
 
 	// Register Webhook handler under /webhook
-	mux.Handle("/webhook/", webhook.NewWebhookHandler())
+	webhookHandler := webhook.NewWebhookHandler()
+	mux.Handle("/webhook/", webhookHandler)
+
+	// Register Prometheus metrics under /metrics
+	mux.Handle("/metrics", metrics.Handler())
 
 	// Wrap with middleware
 	handler := frontend.NewEnvironmentHandler(
@@ -210,6 +514,31 @@ func listenApi() {
 
 	// Start the server
 	port := common.Getenv("API_SERVER_PORT", "3030")
-	err := http.ListenAndServe(":"+port, handler)
-	log.Fatal("main", "API server: %v", err)
+	server := &http.Server{Addr: ":" + port, Handler: handler}
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serverErr:
+		log.Fatal("main", "API server: %v", err)
+		return
+	case <-ctx.Done():
+	}
+
+	log.Info("main", "Shutting down API server.")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	// Refuse new datapushes first, so that the sensors send them to another instance.
+	if err := webhookHandler.Shutdown(shutdownCtx); err != nil {
+		log.Error("webhook", "Shutting down: %v", err)
+	}
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Error("main", "Shutting down API server: %v", err)
+	}
+	if err := <-serverErr; !errors.Is(err, http.ErrServerClosed) {
+		log.Error("main", "API server: %v", err)
+	}
 }
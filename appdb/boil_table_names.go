@@ -4,11 +4,31 @@
 package appdb
 
 var TableNames = struct {
-	Asset         string
-	Configuration string
-	Sensor        string
+	Asset              string
+	ClassCount         string
+	Configuration      string
+	CounterFrame       string
+	DatapushArchive    string
+	DatapushDeadLetter string
+	DatapushPackage    string
+	DatapushQueue      string
+	Geometry           string
+	GroupMapping       string
+	Heatmap            string
+	Sensor             string
+	SensorHealth       string
 }{
-	Asset:         "asset",
-	Configuration: "configuration",
-	Sensor:        "sensor",
+	Asset:              "asset",
+	ClassCount:         "class_count",
+	Configuration:      "configuration",
+	CounterFrame:       "counter_frame",
+	DatapushArchive:    "datapush_archive",
+	DatapushDeadLetter: "datapush_dead_letter",
+	DatapushPackage:    "datapush_package",
+	DatapushQueue:      "datapush_queue",
+	Geometry:           "geometry",
+	GroupMapping:       "group_mapping",
+	Heatmap:            "heatmap",
+	Sensor:             "sensor",
+	SensorHealth:       "sensor_health",
 }
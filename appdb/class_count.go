@@ -0,0 +1,1055 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ClassCount is an object representing the database table.
+type ClassCount struct {
+	SerialNumber string `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	CounterID    int32  `boil:"counter_id" json:"counter_id" toml:"counter_id" yaml:"counter_id"`
+	Class        string `boil:"class" json:"class" toml:"class" yaml:"class"`
+	Count        int64  `boil:"count" json:"count" toml:"count" yaml:"count"`
+
+	R *classCountR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L classCountL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ClassCountColumns = struct {
+	SerialNumber string
+	CounterID    string
+	Class        string
+	Count        string
+}{
+	SerialNumber: "serial_number",
+	CounterID:    "counter_id",
+	Class:        "class",
+	Count:        "count",
+}
+
+var ClassCountTableColumns = struct {
+	SerialNumber string
+	CounterID    string
+	Class        string
+	Count        string
+}{
+	SerialNumber: "class_count.serial_number",
+	CounterID:    "class_count.counter_id",
+	Class:        "class_count.class",
+	Count:        "class_count.count",
+}
+
+// Generated where
+
+type whereHelperint32 struct{ field string }
+
+func (w whereHelperint32) EQ(x int32) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint32) NEQ(x int32) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint32) LT(x int32) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint32) LTE(x int32) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint32) GT(x int32) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint32) GTE(x int32) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint32) IN(slice []int32) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint32) NIN(slice []int32) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var ClassCountWhere = struct {
+	SerialNumber whereHelperstring
+	CounterID    whereHelperint32
+	Class        whereHelperstring
+	Count        whereHelperint64
+}{
+	SerialNumber: whereHelperstring{field: "\"xovis2\".\"class_count\".\"serial_number\""},
+	CounterID:    whereHelperint32{field: "\"xovis2\".\"class_count\".\"counter_id\""},
+	Class:        whereHelperstring{field: "\"xovis2\".\"class_count\".\"class\""},
+	Count:        whereHelperint64{field: "\"xovis2\".\"class_count\".\"count\""},
+}
+
+// ClassCountRels is where relationship names are stored.
+var ClassCountRels = struct {
+}{}
+
+// classCountR is where relationships are stored.
+type classCountR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*classCountR) NewStruct() *classCountR {
+	return &classCountR{}
+}
+
+// classCountL is where Load methods for each relationship are stored.
+type classCountL struct{}
+
+var (
+	classCountAllColumns            = []string{"serial_number", "counter_id", "class", "count"}
+	classCountColumnsWithoutDefault = []string{"serial_number", "counter_id", "class", "count"}
+	classCountColumnsWithDefault    = []string{}
+	classCountPrimaryKeyColumns     = []string{"serial_number", "counter_id", "class"}
+	classCountGeneratedColumns      = []string{}
+)
+
+type (
+	// ClassCountSlice is an alias for a slice of pointers to ClassCount.
+	// This should almost always be used instead of []ClassCount.
+	ClassCountSlice []*ClassCount
+	// ClassCountHook is the signature for custom ClassCount hook methods
+	ClassCountHook func(context.Context, boil.ContextExecutor, *ClassCount) error
+
+	classCountQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	classCountType                 = reflect.TypeOf(&ClassCount{})
+	classCountMapping              = queries.MakeStructMapping(classCountType)
+	classCountPrimaryKeyMapping, _ = queries.BindMapping(classCountType, classCountMapping, classCountPrimaryKeyColumns)
+	classCountInsertCacheMut       sync.RWMutex
+	classCountInsertCache          = make(map[string]insertCache)
+	classCountUpdateCacheMut       sync.RWMutex
+	classCountUpdateCache          = make(map[string]updateCache)
+	classCountUpsertCacheMut       sync.RWMutex
+	classCountUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var classCountAfterSelectMu sync.Mutex
+var classCountAfterSelectHooks []ClassCountHook
+
+var classCountBeforeInsertMu sync.Mutex
+var classCountBeforeInsertHooks []ClassCountHook
+var classCountAfterInsertMu sync.Mutex
+var classCountAfterInsertHooks []ClassCountHook
+
+var classCountBeforeUpdateMu sync.Mutex
+var classCountBeforeUpdateHooks []ClassCountHook
+var classCountAfterUpdateMu sync.Mutex
+var classCountAfterUpdateHooks []ClassCountHook
+
+var classCountBeforeDeleteMu sync.Mutex
+var classCountBeforeDeleteHooks []ClassCountHook
+var classCountAfterDeleteMu sync.Mutex
+var classCountAfterDeleteHooks []ClassCountHook
+
+var classCountBeforeUpsertMu sync.Mutex
+var classCountBeforeUpsertHooks []ClassCountHook
+var classCountAfterUpsertMu sync.Mutex
+var classCountAfterUpsertHooks []ClassCountHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ClassCount) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range classCountAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ClassCount) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range classCountBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ClassCount) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range classCountAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ClassCount) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range classCountBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ClassCount) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range classCountAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ClassCount) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range classCountBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ClassCount) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range classCountAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ClassCount) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range classCountBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ClassCount) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range classCountAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddClassCountHook registers your hook function for all future operations.
+func AddClassCountHook(hookPoint boil.HookPoint, classCountHook ClassCountHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		classCountAfterSelectMu.Lock()
+		classCountAfterSelectHooks = append(classCountAfterSelectHooks, classCountHook)
+		classCountAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		classCountBeforeInsertMu.Lock()
+		classCountBeforeInsertHooks = append(classCountBeforeInsertHooks, classCountHook)
+		classCountBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		classCountAfterInsertMu.Lock()
+		classCountAfterInsertHooks = append(classCountAfterInsertHooks, classCountHook)
+		classCountAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		classCountBeforeUpdateMu.Lock()
+		classCountBeforeUpdateHooks = append(classCountBeforeUpdateHooks, classCountHook)
+		classCountBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		classCountAfterUpdateMu.Lock()
+		classCountAfterUpdateHooks = append(classCountAfterUpdateHooks, classCountHook)
+		classCountAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		classCountBeforeDeleteMu.Lock()
+		classCountBeforeDeleteHooks = append(classCountBeforeDeleteHooks, classCountHook)
+		classCountBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		classCountAfterDeleteMu.Lock()
+		classCountAfterDeleteHooks = append(classCountAfterDeleteHooks, classCountHook)
+		classCountAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		classCountBeforeUpsertMu.Lock()
+		classCountBeforeUpsertHooks = append(classCountBeforeUpsertHooks, classCountHook)
+		classCountBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		classCountAfterUpsertMu.Lock()
+		classCountAfterUpsertHooks = append(classCountAfterUpsertHooks, classCountHook)
+		classCountAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single classCount record from the query using the global executor.
+func (q classCountQuery) OneG(ctx context.Context) (*ClassCount, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single classCount record from the query.
+func (q classCountQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ClassCount, error) {
+	o := &ClassCount{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for class_count")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ClassCount records from the query using the global executor.
+func (q classCountQuery) AllG(ctx context.Context) (ClassCountSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ClassCount records from the query.
+func (q classCountQuery) All(ctx context.Context, exec boil.ContextExecutor) (ClassCountSlice, error) {
+	var o []*ClassCount
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to ClassCount slice")
+	}
+
+	if len(classCountAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ClassCount records in the query using the global executor
+func (q classCountQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ClassCount records in the query.
+func (q classCountQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count class_count rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q classCountQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q classCountQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if class_count exists")
+	}
+
+	return count > 0, nil
+}
+
+// ClassCounts retrieves all the records using an executor.
+func ClassCounts(mods ...qm.QueryMod) classCountQuery {
+	mods = append(mods, qm.From("\"xovis2\".\"class_count\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"xovis2\".\"class_count\".*"})
+	}
+
+	return classCountQuery{q}
+}
+
+// FindClassCountG retrieves a single record by ID.
+func FindClassCountG(ctx context.Context, serialNumber string, counterID int32, class string, selectCols ...string) (*ClassCount, error) {
+	return FindClassCount(ctx, boil.GetContextDB(), serialNumber, counterID, class, selectCols...)
+}
+
+// FindClassCount retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindClassCount(ctx context.Context, exec boil.ContextExecutor, serialNumber string, counterID int32, class string, selectCols ...string) (*ClassCount, error) {
+	classCountObj := &ClassCount{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"xovis2\".\"class_count\" where \"serial_number\"=$1 AND \"counter_id\"=$2 AND \"class\"=$3", sel,
+	)
+
+	q := queries.Raw(query, serialNumber, counterID, class)
+
+	err := q.Bind(ctx, exec, classCountObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from class_count")
+	}
+
+	if err = classCountObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return classCountObj, err
+	}
+
+	return classCountObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ClassCount) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ClassCount) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no class_count provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(classCountColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	classCountInsertCacheMut.RLock()
+	cache, cached := classCountInsertCache[key]
+	classCountInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			classCountAllColumns,
+			classCountColumnsWithDefault,
+			classCountColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(classCountType, classCountMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(classCountType, classCountMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"xovis2\".\"class_count\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"xovis2\".\"class_count\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into class_count")
+	}
+
+	if !cached {
+		classCountInsertCacheMut.Lock()
+		classCountInsertCache[key] = cache
+		classCountInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ClassCount record using the global executor.
+// See Update for more documentation.
+func (o *ClassCount) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ClassCount.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ClassCount) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	classCountUpdateCacheMut.RLock()
+	cache, cached := classCountUpdateCache[key]
+	classCountUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			classCountAllColumns,
+			classCountPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update class_count, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"xovis2\".\"class_count\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, classCountPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(classCountType, classCountMapping, append(wl, classCountPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update class_count row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for class_count")
+	}
+
+	if !cached {
+		classCountUpdateCacheMut.Lock()
+		classCountUpdateCache[key] = cache
+		classCountUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q classCountQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q classCountQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for class_count")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for class_count")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ClassCountSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ClassCountSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), classCountPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"xovis2\".\"class_count\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, classCountPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in classCount slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all classCount")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ClassCount) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ClassCount) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no class_count provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(classCountColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	classCountUpsertCacheMut.RLock()
+	cache, cached := classCountUpsertCache[key]
+	classCountUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			classCountAllColumns,
+			classCountColumnsWithDefault,
+			classCountColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			classCountAllColumns,
+			classCountPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert class_count, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(classCountAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(classCountPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert class_count, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(classCountPrimaryKeyColumns))
+			copy(conflict, classCountPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"xovis2\".\"class_count\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(classCountType, classCountMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(classCountType, classCountMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert class_count")
+	}
+
+	if !cached {
+		classCountUpsertCacheMut.Lock()
+		classCountUpsertCache[key] = cache
+		classCountUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ClassCount record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ClassCount) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ClassCount record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ClassCount) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no ClassCount provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), classCountPrimaryKeyMapping)
+	sql := "DELETE FROM \"xovis2\".\"class_count\" WHERE \"serial_number\"=$1 AND \"counter_id\"=$2 AND \"class\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from class_count")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for class_count")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q classCountQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q classCountQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no classCountQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from class_count")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for class_count")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ClassCountSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ClassCountSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(classCountBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), classCountPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"xovis2\".\"class_count\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, classCountPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from classCount slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for class_count")
+	}
+
+	if len(classCountAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ClassCount) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no ClassCount provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ClassCount) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindClassCount(ctx, exec, o.SerialNumber, o.CounterID, o.Class)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ClassCountSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty ClassCountSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ClassCountSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ClassCountSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), classCountPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"xovis2\".\"class_count\".* FROM \"xovis2\".\"class_count\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, classCountPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in ClassCountSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ClassCountExistsG checks if the ClassCount row exists.
+func ClassCountExistsG(ctx context.Context, serialNumber string, counterID int32, class string) (bool, error) {
+	return ClassCountExists(ctx, boil.GetContextDB(), serialNumber, counterID, class)
+}
+
+// ClassCountExists checks if the ClassCount row exists.
+func ClassCountExists(ctx context.Context, exec boil.ContextExecutor, serialNumber string, counterID int32, class string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"xovis2\".\"class_count\" where \"serial_number\"=$1 AND \"counter_id\"=$2 AND \"class\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, serialNumber, counterID, class)
+	}
+	row := exec.QueryRowContext(ctx, sql, serialNumber, counterID, class)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if class_count exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ClassCount row exists.
+func (o *ClassCount) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ClassCountExists(ctx, exec, o.SerialNumber, o.CounterID, o.Class)
+}
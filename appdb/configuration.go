@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -24,57 +25,152 @@ import (
 
 // Configuration is an object representing the database table.
 type Configuration struct {
-	ID               int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
-	CheckCertificate bool              `boil:"check_certificate" json:"check_certificate" toml:"check_certificate" yaml:"check_certificate"`
-	RefreshInterval  int32             `boil:"refresh_interval" json:"refresh_interval" toml:"refresh_interval" yaml:"refresh_interval"`
-	RequestTimeout   int32             `boil:"request_timeout" json:"request_timeout" toml:"request_timeout" yaml:"request_timeout"`
-	Active           bool              `boil:"active" json:"active" toml:"active" yaml:"active"`
-	Enable           bool              `boil:"enable" json:"enable" toml:"enable" yaml:"enable"`
-	ProjectIds       types.StringArray `boil:"project_ids" json:"project_ids" toml:"project_ids" yaml:"project_ids"`
-	UserID           string            `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	ID                    int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CheckCertificate      bool              `boil:"check_certificate" json:"check_certificate" toml:"check_certificate" yaml:"check_certificate"`
+	RefreshInterval       int32             `boil:"refresh_interval" json:"refresh_interval" toml:"refresh_interval" yaml:"refresh_interval"`
+	RequestTimeout        int32             `boil:"request_timeout" json:"request_timeout" toml:"request_timeout" yaml:"request_timeout"`
+	Active                bool              `boil:"active" json:"active" toml:"active" yaml:"active"`
+	Enable                bool              `boil:"enable" json:"enable" toml:"enable" yaml:"enable"`
+	ProjectIds            types.StringArray `boil:"project_ids" json:"project_ids" toml:"project_ids" yaml:"project_ids"`
+	UserID                string            `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	HierarchyMode         string            `boil:"hierarchy_mode" json:"hierarchy_mode" toml:"hierarchy_mode" yaml:"hierarchy_mode"`
+	CaBundle              null.String       `boil:"ca_bundle" json:"ca_bundle,omitempty" toml:"ca_bundle" yaml:"ca_bundle,omitempty"`
+	ProxyURL              null.String       `boil:"proxy_url" json:"proxy_url,omitempty" toml:"proxy_url" yaml:"proxy_url,omitempty"`
+	MQTTBrokerURL         null.String       `boil:"mqtt_broker_url" json:"mqtt_broker_url,omitempty" toml:"mqtt_broker_url" yaml:"mqtt_broker_url,omitempty"`
+	MQTTTopics            types.StringArray `boil:"mqtt_topics" json:"mqtt_topics" toml:"mqtt_topics" yaml:"mqtt_topics"`
+	MQTTUsername          null.String       `boil:"mqtt_username" json:"mqtt_username,omitempty" toml:"mqtt_username" yaml:"mqtt_username,omitempty"`
+	MQTTPassword          null.String       `boil:"mqtt_password" json:"mqtt_password,omitempty" toml:"mqtt_password" yaml:"mqtt_password,omitempty"`
+	PreferLogicsPush      bool              `boil:"prefer_logics_push" json:"prefer_logics_push" toml:"prefer_logics_push" yaml:"prefer_logics_push"`
+	HealthAlarms          bool              `boil:"health_alarms" json:"health_alarms" toml:"health_alarms" yaml:"health_alarms"`
+	TrackingEnabled       bool              `boil:"tracking_enabled" json:"tracking_enabled" toml:"tracking_enabled" yaml:"tracking_enabled"`
+	HeatmapCellSize       float64           `boil:"heatmap_cell_size" json:"heatmap_cell_size" toml:"heatmap_cell_size" yaml:"heatmap_cell_size"`
+	HeatmapWindow         int32             `boil:"heatmap_window" json:"heatmap_window" toml:"heatmap_window" yaml:"heatmap_window"`
+	ArchiveEnabled        bool              `boil:"archive_enabled" json:"archive_enabled" toml:"archive_enabled" yaml:"archive_enabled"`
+	ArchiveMaxAge         int32             `boil:"archive_max_age" json:"archive_max_age" toml:"archive_max_age" yaml:"archive_max_age"`
+	ArchiveMaxSize        int64             `boil:"archive_max_size" json:"archive_max_size" toml:"archive_max_size" yaml:"archive_max_size"`
+	ClassificationEnabled bool              `boil:"classification_enabled" json:"classification_enabled" toml:"classification_enabled" yaml:"classification_enabled"`
+	ChildMaxHeight        float64           `boil:"child_max_height" json:"child_max_height" toml:"child_max_height" yaml:"child_max_height"`
+	GroupMinMembers       int32             `boil:"group_min_members" json:"group_min_members" toml:"group_min_members" yaml:"group_min_members"`
+	StaffTag              string            `boil:"staff_tag" json:"staff_tag" toml:"staff_tag" yaml:"staff_tag"`
 
 	R *configurationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L configurationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ConfigurationColumns = struct {
-	ID               string
-	CheckCertificate string
-	RefreshInterval  string
-	RequestTimeout   string
-	Active           string
-	Enable           string
-	ProjectIds       string
-	UserID           string
+	ID                    string
+	CheckCertificate      string
+	RefreshInterval       string
+	RequestTimeout        string
+	Active                string
+	Enable                string
+	ProjectIds            string
+	UserID                string
+	HierarchyMode         string
+	CaBundle              string
+	ProxyURL              string
+	MQTTBrokerURL         string
+	MQTTTopics            string
+	MQTTUsername          string
+	MQTTPassword          string
+	PreferLogicsPush      string
+	HealthAlarms          string
+	TrackingEnabled       string
+	HeatmapCellSize       string
+	HeatmapWindow         string
+	ArchiveEnabled        string
+	ArchiveMaxAge         string
+	ArchiveMaxSize        string
+	ClassificationEnabled string
+	ChildMaxHeight        string
+	GroupMinMembers       string
+	StaffTag              string
 }{
-	ID:               "id",
-	CheckCertificate: "check_certificate",
-	RefreshInterval:  "refresh_interval",
-	RequestTimeout:   "request_timeout",
-	Active:           "active",
-	Enable:           "enable",
-	ProjectIds:       "project_ids",
-	UserID:           "user_id",
+	ID:                    "id",
+	CheckCertificate:      "check_certificate",
+	RefreshInterval:       "refresh_interval",
+	RequestTimeout:        "request_timeout",
+	Active:                "active",
+	Enable:                "enable",
+	ProjectIds:            "project_ids",
+	UserID:                "user_id",
+	HierarchyMode:         "hierarchy_mode",
+	CaBundle:              "ca_bundle",
+	ProxyURL:              "proxy_url",
+	MQTTBrokerURL:         "mqtt_broker_url",
+	MQTTTopics:            "mqtt_topics",
+	MQTTUsername:          "mqtt_username",
+	MQTTPassword:          "mqtt_password",
+	PreferLogicsPush:      "prefer_logics_push",
+	HealthAlarms:          "health_alarms",
+	TrackingEnabled:       "tracking_enabled",
+	HeatmapCellSize:       "heatmap_cell_size",
+	HeatmapWindow:         "heatmap_window",
+	ArchiveEnabled:        "archive_enabled",
+	ArchiveMaxAge:         "archive_max_age",
+	ArchiveMaxSize:        "archive_max_size",
+	ClassificationEnabled: "classification_enabled",
+	ChildMaxHeight:        "child_max_height",
+	GroupMinMembers:       "group_min_members",
+	StaffTag:              "staff_tag",
 }
 
 var ConfigurationTableColumns = struct {
-	ID               string
-	CheckCertificate string
-	RefreshInterval  string
-	RequestTimeout   string
-	Active           string
-	Enable           string
-	ProjectIds       string
-	UserID           string
+	ID                    string
+	CheckCertificate      string
+	RefreshInterval       string
+	RequestTimeout        string
+	Active                string
+	Enable                string
+	ProjectIds            string
+	UserID                string
+	HierarchyMode         string
+	CaBundle              string
+	ProxyURL              string
+	MQTTBrokerURL         string
+	MQTTTopics            string
+	MQTTUsername          string
+	MQTTPassword          string
+	PreferLogicsPush      string
+	HealthAlarms          string
+	TrackingEnabled       string
+	HeatmapCellSize       string
+	HeatmapWindow         string
+	ArchiveEnabled        string
+	ArchiveMaxAge         string
+	ArchiveMaxSize        string
+	ClassificationEnabled string
+	ChildMaxHeight        string
+	GroupMinMembers       string
+	StaffTag              string
 }{
-	ID:               "configuration.id",
-	CheckCertificate: "configuration.check_certificate",
-	RefreshInterval:  "configuration.refresh_interval",
-	RequestTimeout:   "configuration.request_timeout",
-	Active:           "configuration.active",
-	Enable:           "configuration.enable",
-	ProjectIds:       "configuration.project_ids",
-	UserID:           "configuration.user_id",
+	ID:                    "configuration.id",
+	CheckCertificate:      "configuration.check_certificate",
+	RefreshInterval:       "configuration.refresh_interval",
+	RequestTimeout:        "configuration.request_timeout",
+	Active:                "configuration.active",
+	Enable:                "configuration.enable",
+	ProjectIds:            "configuration.project_ids",
+	UserID:                "configuration.user_id",
+	HierarchyMode:         "configuration.hierarchy_mode",
+	CaBundle:              "configuration.ca_bundle",
+	ProxyURL:              "configuration.proxy_url",
+	MQTTBrokerURL:         "configuration.mqtt_broker_url",
+	MQTTTopics:            "configuration.mqtt_topics",
+	MQTTUsername:          "configuration.mqtt_username",
+	MQTTPassword:          "configuration.mqtt_password",
+	PreferLogicsPush:      "configuration.prefer_logics_push",
+	HealthAlarms:          "configuration.health_alarms",
+	TrackingEnabled:       "configuration.tracking_enabled",
+	HeatmapCellSize:       "configuration.heatmap_cell_size",
+	HeatmapWindow:         "configuration.heatmap_window",
+	ArchiveEnabled:        "configuration.archive_enabled",
+	ArchiveMaxAge:         "configuration.archive_max_age",
+	ArchiveMaxSize:        "configuration.archive_max_size",
+	ClassificationEnabled: "configuration.classification_enabled",
+	ChildMaxHeight:        "configuration.child_max_height",
+	GroupMinMembers:       "configuration.group_min_members",
+	StaffTag:              "configuration.staff_tag",
 }
 
 // Generated where
@@ -88,22 +184,73 @@ func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field
 func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
-type whereHelperint32 struct{ field string }
+type whereHelpertypes_StringArray struct{ field string }
 
-func (w whereHelperint32) EQ(x int32) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint32) NEQ(x int32) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint32) LT(x int32) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint32) LTE(x int32) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint32) GT(x int32) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint32) GTE(x int32) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint32) IN(slice []int32) qm.QueryMod {
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" ILIKE ?", x)
+}
+func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT ILIKE ?", x)
+}
+func (w whereHelpernull_String) SIMILAR(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" SIMILAR TO ?", x)
+}
+func (w whereHelpernull_String) NSIMILAR(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
 	}
 	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
 }
-func (w whereHelperint32) NIN(slice []int32) qm.QueryMod {
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
@@ -111,60 +258,115 @@ func (w whereHelperint32) NIN(slice []int32) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-type whereHelpertypes_StringArray struct{ field string }
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+type whereHelperfloat64 struct{ field string }
+
+func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.NEQ, x)
 }
-func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
+func (w whereHelperfloat64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
 
 var ConfigurationWhere = struct {
-	ID               whereHelperint64
-	CheckCertificate whereHelperbool
-	RefreshInterval  whereHelperint32
-	RequestTimeout   whereHelperint32
-	Active           whereHelperbool
-	Enable           whereHelperbool
-	ProjectIds       whereHelpertypes_StringArray
-	UserID           whereHelperstring
+	ID                    whereHelperint64
+	CheckCertificate      whereHelperbool
+	RefreshInterval       whereHelperint32
+	RequestTimeout        whereHelperint32
+	Active                whereHelperbool
+	Enable                whereHelperbool
+	ProjectIds            whereHelpertypes_StringArray
+	UserID                whereHelperstring
+	HierarchyMode         whereHelperstring
+	CaBundle              whereHelpernull_String
+	ProxyURL              whereHelpernull_String
+	MQTTBrokerURL         whereHelpernull_String
+	MQTTTopics            whereHelpertypes_StringArray
+	MQTTUsername          whereHelpernull_String
+	MQTTPassword          whereHelpernull_String
+	PreferLogicsPush      whereHelperbool
+	HealthAlarms          whereHelperbool
+	TrackingEnabled       whereHelperbool
+	HeatmapCellSize       whereHelperfloat64
+	HeatmapWindow         whereHelperint32
+	ArchiveEnabled        whereHelperbool
+	ArchiveMaxAge         whereHelperint32
+	ArchiveMaxSize        whereHelperint64
+	ClassificationEnabled whereHelperbool
+	ChildMaxHeight        whereHelperfloat64
+	GroupMinMembers       whereHelperint32
+	StaffTag              whereHelperstring
 }{
-	ID:               whereHelperint64{field: "\"xovis2\".\"configuration\".\"id\""},
-	CheckCertificate: whereHelperbool{field: "\"xovis2\".\"configuration\".\"check_certificate\""},
-	RefreshInterval:  whereHelperint32{field: "\"xovis2\".\"configuration\".\"refresh_interval\""},
-	RequestTimeout:   whereHelperint32{field: "\"xovis2\".\"configuration\".\"request_timeout\""},
-	Active:           whereHelperbool{field: "\"xovis2\".\"configuration\".\"active\""},
-	Enable:           whereHelperbool{field: "\"xovis2\".\"configuration\".\"enable\""},
-	ProjectIds:       whereHelpertypes_StringArray{field: "\"xovis2\".\"configuration\".\"project_ids\""},
-	UserID:           whereHelperstring{field: "\"xovis2\".\"configuration\".\"user_id\""},
+	ID:                    whereHelperint64{field: "\"xovis2\".\"configuration\".\"id\""},
+	CheckCertificate:      whereHelperbool{field: "\"xovis2\".\"configuration\".\"check_certificate\""},
+	RefreshInterval:       whereHelperint32{field: "\"xovis2\".\"configuration\".\"refresh_interval\""},
+	RequestTimeout:        whereHelperint32{field: "\"xovis2\".\"configuration\".\"request_timeout\""},
+	Active:                whereHelperbool{field: "\"xovis2\".\"configuration\".\"active\""},
+	Enable:                whereHelperbool{field: "\"xovis2\".\"configuration\".\"enable\""},
+	ProjectIds:            whereHelpertypes_StringArray{field: "\"xovis2\".\"configuration\".\"project_ids\""},
+	UserID:                whereHelperstring{field: "\"xovis2\".\"configuration\".\"user_id\""},
+	HierarchyMode:         whereHelperstring{field: "\"xovis2\".\"configuration\".\"hierarchy_mode\""},
+	CaBundle:              whereHelpernull_String{field: "\"xovis2\".\"configuration\".\"ca_bundle\""},
+	ProxyURL:              whereHelpernull_String{field: "\"xovis2\".\"configuration\".\"proxy_url\""},
+	MQTTBrokerURL:         whereHelpernull_String{field: "\"xovis2\".\"configuration\".\"mqtt_broker_url\""},
+	MQTTTopics:            whereHelpertypes_StringArray{field: "\"xovis2\".\"configuration\".\"mqtt_topics\""},
+	MQTTUsername:          whereHelpernull_String{field: "\"xovis2\".\"configuration\".\"mqtt_username\""},
+	MQTTPassword:          whereHelpernull_String{field: "\"xovis2\".\"configuration\".\"mqtt_password\""},
+	PreferLogicsPush:      whereHelperbool{field: "\"xovis2\".\"configuration\".\"prefer_logics_push\""},
+	HealthAlarms:          whereHelperbool{field: "\"xovis2\".\"configuration\".\"health_alarms\""},
+	TrackingEnabled:       whereHelperbool{field: "\"xovis2\".\"configuration\".\"tracking_enabled\""},
+	HeatmapCellSize:       whereHelperfloat64{field: "\"xovis2\".\"configuration\".\"heatmap_cell_size\""},
+	HeatmapWindow:         whereHelperint32{field: "\"xovis2\".\"configuration\".\"heatmap_window\""},
+	ArchiveEnabled:        whereHelperbool{field: "\"xovis2\".\"configuration\".\"archive_enabled\""},
+	ArchiveMaxAge:         whereHelperint32{field: "\"xovis2\".\"configuration\".\"archive_max_age\""},
+	ArchiveMaxSize:        whereHelperint64{field: "\"xovis2\".\"configuration\".\"archive_max_size\""},
+	ClassificationEnabled: whereHelperbool{field: "\"xovis2\".\"configuration\".\"classification_enabled\""},
+	ChildMaxHeight:        whereHelperfloat64{field: "\"xovis2\".\"configuration\".\"child_max_height\""},
+	GroupMinMembers:       whereHelperint32{field: "\"xovis2\".\"configuration\".\"group_min_members\""},
+	StaffTag:              whereHelperstring{field: "\"xovis2\".\"configuration\".\"staff_tag\""},
 }
 
 // ConfigurationRels is where relationship names are stored.
 var ConfigurationRels = struct {
-	Assets  string
-	Sensors string
+	Assets           string
+	DatapushArchives string
+	GroupMappings    string
+	Sensors          string
 }{
-	Assets:  "Assets",
-	Sensors: "Sensors",
+	Assets:           "Assets",
+	DatapushArchives: "DatapushArchives",
+	GroupMappings:    "GroupMappings",
+	Sensors:          "Sensors",
 }
 
 // configurationR is where relationships are stored.
 type configurationR struct {
-	Assets  AssetSlice  `boil:"Assets" json:"Assets" toml:"Assets" yaml:"Assets"`
-	Sensors SensorSlice `boil:"Sensors" json:"Sensors" toml:"Sensors" yaml:"Sensors"`
+	Assets           AssetSlice           `boil:"Assets" json:"Assets" toml:"Assets" yaml:"Assets"`
+	DatapushArchives DatapushArchiveSlice `boil:"DatapushArchives" json:"DatapushArchives" toml:"DatapushArchives" yaml:"DatapushArchives"`
+	GroupMappings    GroupMappingSlice    `boil:"GroupMappings" json:"GroupMappings" toml:"GroupMappings" yaml:"GroupMappings"`
+	Sensors          SensorSlice          `boil:"Sensors" json:"Sensors" toml:"Sensors" yaml:"Sensors"`
 }
 
 // NewStruct creates a new relationship struct
@@ -179,6 +381,20 @@ func (r *configurationR) GetAssets() AssetSlice {
 	return r.Assets
 }
 
+func (r *configurationR) GetDatapushArchives() DatapushArchiveSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DatapushArchives
+}
+
+func (r *configurationR) GetGroupMappings() GroupMappingSlice {
+	if r == nil {
+		return nil
+	}
+	return r.GroupMappings
+}
+
 func (r *configurationR) GetSensors() SensorSlice {
 	if r == nil {
 		return nil
@@ -190,9 +406,9 @@ func (r *configurationR) GetSensors() SensorSlice {
 type configurationL struct{}
 
 var (
-	configurationAllColumns            = []string{"id", "check_certificate", "refresh_interval", "request_timeout", "active", "enable", "project_ids", "user_id"}
+	configurationAllColumns            = []string{"id", "check_certificate", "refresh_interval", "request_timeout", "active", "enable", "project_ids", "user_id", "hierarchy_mode", "ca_bundle", "proxy_url", "mqtt_broker_url", "mqtt_topics", "mqtt_username", "mqtt_password", "prefer_logics_push", "health_alarms", "tracking_enabled", "heatmap_cell_size", "heatmap_window", "archive_enabled", "archive_max_age", "archive_max_size", "classification_enabled", "child_max_height", "group_min_members", "staff_tag"}
 	configurationColumnsWithoutDefault = []string{"check_certificate", "project_ids", "user_id"}
-	configurationColumnsWithDefault    = []string{"id", "refresh_interval", "request_timeout", "active", "enable"}
+	configurationColumnsWithDefault    = []string{"id", "refresh_interval", "request_timeout", "active", "enable", "hierarchy_mode", "ca_bundle", "proxy_url", "mqtt_broker_url", "mqtt_topics", "mqtt_username", "mqtt_password", "prefer_logics_push", "health_alarms", "tracking_enabled", "heatmap_cell_size", "heatmap_window", "archive_enabled", "archive_max_age", "archive_max_size", "classification_enabled", "child_max_height", "group_min_members", "staff_tag"}
 	configurationPrimaryKeyColumns     = []string{"id"}
 	configurationGeneratedColumns      = []string{}
 )
@@ -536,6 +752,34 @@ func (o *Configuration) Assets(mods ...qm.QueryMod) assetQuery {
 	return Assets(queryMods...)
 }
 
+// DatapushArchives retrieves all the datapush_archive's DatapushArchives with an executor.
+func (o *Configuration) DatapushArchives(mods ...qm.QueryMod) datapushArchiveQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"xovis2\".\"datapush_archive\".\"configuration_id\"=?", o.ID),
+	)
+
+	return DatapushArchives(queryMods...)
+}
+
+// GroupMappings retrieves all the group_mapping's GroupMappings with an executor.
+func (o *Configuration) GroupMappings(mods ...qm.QueryMod) groupMappingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"xovis2\".\"group_mapping\".\"configuration_id\"=?", o.ID),
+	)
+
+	return GroupMappings(queryMods...)
+}
+
 // Sensors retrieves all the sensor's Sensors with an executor.
 func (o *Configuration) Sensors(mods ...qm.QueryMod) sensorQuery {
 	var queryMods []qm.QueryMod
@@ -663,6 +907,232 @@ func (configurationL) LoadAssets(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
+// LoadDatapushArchives allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadDatapushArchives(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`xovis2.datapush_archive`),
+		qm.WhereIn(`xovis2.datapush_archive.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load datapush_archive")
+	}
+
+	var resultSlice []*DatapushArchive
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice datapush_archive")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on datapush_archive")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datapush_archive")
+	}
+
+	if len(datapushArchiveAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DatapushArchives = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &datapushArchiveR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.DatapushArchives = append(local.R.DatapushArchives, foreign)
+				if foreign.R == nil {
+					foreign.R = &datapushArchiveR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGroupMappings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadGroupMappings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`xovis2.group_mapping`),
+		qm.WhereIn(`xovis2.group_mapping.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load group_mapping")
+	}
+
+	var resultSlice []*GroupMapping
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice group_mapping")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on group_mapping")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_mapping")
+	}
+
+	if len(groupMappingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GroupMappings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupMappingR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.GroupMappings = append(local.R.GroupMappings, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupMappingR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadSensors allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (configurationL) LoadSensors(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
@@ -838,6 +1308,130 @@ func (o *Configuration) AddAssets(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
+// AddDatapushArchivesG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.DatapushArchives.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddDatapushArchivesG(ctx context.Context, insert bool, related ...*DatapushArchive) error {
+	return o.AddDatapushArchives(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddDatapushArchives adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.DatapushArchives.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddDatapushArchives(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DatapushArchive) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"xovis2\".\"datapush_archive\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, datapushArchivePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			DatapushArchives: related,
+		}
+	} else {
+		o.R.DatapushArchives = append(o.R.DatapushArchives, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &datapushArchiveR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddGroupMappingsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.GroupMappings.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddGroupMappingsG(ctx context.Context, insert bool, related ...*GroupMapping) error {
+	return o.AddGroupMappings(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddGroupMappings adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.GroupMappings.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddGroupMappings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*GroupMapping) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"xovis2\".\"group_mapping\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, groupMappingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			GroupMappings: related,
+		}
+	} else {
+		o.R.GroupMappings = append(o.R.GroupMappings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &groupMappingR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
 // AddSensorsG adds the given related objects to the existing relationships
 // of the configuration, optionally inserting them as new records.
 // Appends related to o.R.Sensors.
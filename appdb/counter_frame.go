@@ -0,0 +1,1032 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// CounterFrame is an object representing the database table.
+type CounterFrame struct {
+	SerialNumber string `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	CounterID    int32  `boil:"counter_id" json:"counter_id" toml:"counter_id" yaml:"counter_id"`
+	FrameTime    int64  `boil:"frame_time" json:"frame_time" toml:"frame_time" yaml:"frame_time"`
+	FrameNumber  int64  `boil:"frame_number" json:"frame_number" toml:"frame_number" yaml:"frame_number"`
+
+	R *counterFrameR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L counterFrameL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CounterFrameColumns = struct {
+	SerialNumber string
+	CounterID    string
+	FrameTime    string
+	FrameNumber  string
+}{
+	SerialNumber: "serial_number",
+	CounterID:    "counter_id",
+	FrameTime:    "frame_time",
+	FrameNumber:  "frame_number",
+}
+
+var CounterFrameTableColumns = struct {
+	SerialNumber string
+	CounterID    string
+	FrameTime    string
+	FrameNumber  string
+}{
+	SerialNumber: "counter_frame.serial_number",
+	CounterID:    "counter_frame.counter_id",
+	FrameTime:    "counter_frame.frame_time",
+	FrameNumber:  "counter_frame.frame_number",
+}
+
+// Generated where
+
+var CounterFrameWhere = struct {
+	SerialNumber whereHelperstring
+	CounterID    whereHelperint32
+	FrameTime    whereHelperint64
+	FrameNumber  whereHelperint64
+}{
+	SerialNumber: whereHelperstring{field: "\"xovis2\".\"counter_frame\".\"serial_number\""},
+	CounterID:    whereHelperint32{field: "\"xovis2\".\"counter_frame\".\"counter_id\""},
+	FrameTime:    whereHelperint64{field: "\"xovis2\".\"counter_frame\".\"frame_time\""},
+	FrameNumber:  whereHelperint64{field: "\"xovis2\".\"counter_frame\".\"frame_number\""},
+}
+
+// CounterFrameRels is where relationship names are stored.
+var CounterFrameRels = struct {
+}{}
+
+// counterFrameR is where relationships are stored.
+type counterFrameR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*counterFrameR) NewStruct() *counterFrameR {
+	return &counterFrameR{}
+}
+
+// counterFrameL is where Load methods for each relationship are stored.
+type counterFrameL struct{}
+
+var (
+	counterFrameAllColumns            = []string{"serial_number", "counter_id", "frame_time", "frame_number"}
+	counterFrameColumnsWithoutDefault = []string{"serial_number", "counter_id", "frame_time", "frame_number"}
+	counterFrameColumnsWithDefault    = []string{}
+	counterFramePrimaryKeyColumns     = []string{"serial_number", "counter_id"}
+	counterFrameGeneratedColumns      = []string{}
+)
+
+type (
+	// CounterFrameSlice is an alias for a slice of pointers to CounterFrame.
+	// This should almost always be used instead of []CounterFrame.
+	CounterFrameSlice []*CounterFrame
+	// CounterFrameHook is the signature for custom CounterFrame hook methods
+	CounterFrameHook func(context.Context, boil.ContextExecutor, *CounterFrame) error
+
+	counterFrameQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	counterFrameType                 = reflect.TypeOf(&CounterFrame{})
+	counterFrameMapping              = queries.MakeStructMapping(counterFrameType)
+	counterFramePrimaryKeyMapping, _ = queries.BindMapping(counterFrameType, counterFrameMapping, counterFramePrimaryKeyColumns)
+	counterFrameInsertCacheMut       sync.RWMutex
+	counterFrameInsertCache          = make(map[string]insertCache)
+	counterFrameUpdateCacheMut       sync.RWMutex
+	counterFrameUpdateCache          = make(map[string]updateCache)
+	counterFrameUpsertCacheMut       sync.RWMutex
+	counterFrameUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var counterFrameAfterSelectMu sync.Mutex
+var counterFrameAfterSelectHooks []CounterFrameHook
+
+var counterFrameBeforeInsertMu sync.Mutex
+var counterFrameBeforeInsertHooks []CounterFrameHook
+var counterFrameAfterInsertMu sync.Mutex
+var counterFrameAfterInsertHooks []CounterFrameHook
+
+var counterFrameBeforeUpdateMu sync.Mutex
+var counterFrameBeforeUpdateHooks []CounterFrameHook
+var counterFrameAfterUpdateMu sync.Mutex
+var counterFrameAfterUpdateHooks []CounterFrameHook
+
+var counterFrameBeforeDeleteMu sync.Mutex
+var counterFrameBeforeDeleteHooks []CounterFrameHook
+var counterFrameAfterDeleteMu sync.Mutex
+var counterFrameAfterDeleteHooks []CounterFrameHook
+
+var counterFrameBeforeUpsertMu sync.Mutex
+var counterFrameBeforeUpsertHooks []CounterFrameHook
+var counterFrameAfterUpsertMu sync.Mutex
+var counterFrameAfterUpsertHooks []CounterFrameHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CounterFrame) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterFrameAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CounterFrame) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterFrameBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CounterFrame) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterFrameAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CounterFrame) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterFrameBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CounterFrame) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterFrameAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CounterFrame) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterFrameBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CounterFrame) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterFrameAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CounterFrame) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterFrameBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CounterFrame) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterFrameAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCounterFrameHook registers your hook function for all future operations.
+func AddCounterFrameHook(hookPoint boil.HookPoint, counterFrameHook CounterFrameHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		counterFrameAfterSelectMu.Lock()
+		counterFrameAfterSelectHooks = append(counterFrameAfterSelectHooks, counterFrameHook)
+		counterFrameAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		counterFrameBeforeInsertMu.Lock()
+		counterFrameBeforeInsertHooks = append(counterFrameBeforeInsertHooks, counterFrameHook)
+		counterFrameBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		counterFrameAfterInsertMu.Lock()
+		counterFrameAfterInsertHooks = append(counterFrameAfterInsertHooks, counterFrameHook)
+		counterFrameAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		counterFrameBeforeUpdateMu.Lock()
+		counterFrameBeforeUpdateHooks = append(counterFrameBeforeUpdateHooks, counterFrameHook)
+		counterFrameBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		counterFrameAfterUpdateMu.Lock()
+		counterFrameAfterUpdateHooks = append(counterFrameAfterUpdateHooks, counterFrameHook)
+		counterFrameAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		counterFrameBeforeDeleteMu.Lock()
+		counterFrameBeforeDeleteHooks = append(counterFrameBeforeDeleteHooks, counterFrameHook)
+		counterFrameBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		counterFrameAfterDeleteMu.Lock()
+		counterFrameAfterDeleteHooks = append(counterFrameAfterDeleteHooks, counterFrameHook)
+		counterFrameAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		counterFrameBeforeUpsertMu.Lock()
+		counterFrameBeforeUpsertHooks = append(counterFrameBeforeUpsertHooks, counterFrameHook)
+		counterFrameBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		counterFrameAfterUpsertMu.Lock()
+		counterFrameAfterUpsertHooks = append(counterFrameAfterUpsertHooks, counterFrameHook)
+		counterFrameAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single counterFrame record from the query using the global executor.
+func (q counterFrameQuery) OneG(ctx context.Context) (*CounterFrame, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single counterFrame record from the query.
+func (q counterFrameQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CounterFrame, error) {
+	o := &CounterFrame{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for counter_frame")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all CounterFrame records from the query using the global executor.
+func (q counterFrameQuery) AllG(ctx context.Context) (CounterFrameSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all CounterFrame records from the query.
+func (q counterFrameQuery) All(ctx context.Context, exec boil.ContextExecutor) (CounterFrameSlice, error) {
+	var o []*CounterFrame
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to CounterFrame slice")
+	}
+
+	if len(counterFrameAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all CounterFrame records in the query using the global executor
+func (q counterFrameQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all CounterFrame records in the query.
+func (q counterFrameQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count counter_frame rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q counterFrameQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q counterFrameQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if counter_frame exists")
+	}
+
+	return count > 0, nil
+}
+
+// CounterFrames retrieves all the records using an executor.
+func CounterFrames(mods ...qm.QueryMod) counterFrameQuery {
+	mods = append(mods, qm.From("\"xovis2\".\"counter_frame\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"xovis2\".\"counter_frame\".*"})
+	}
+
+	return counterFrameQuery{q}
+}
+
+// FindCounterFrameG retrieves a single record by ID.
+func FindCounterFrameG(ctx context.Context, serialNumber string, counterID int32, selectCols ...string) (*CounterFrame, error) {
+	return FindCounterFrame(ctx, boil.GetContextDB(), serialNumber, counterID, selectCols...)
+}
+
+// FindCounterFrame retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCounterFrame(ctx context.Context, exec boil.ContextExecutor, serialNumber string, counterID int32, selectCols ...string) (*CounterFrame, error) {
+	counterFrameObj := &CounterFrame{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"xovis2\".\"counter_frame\" where \"serial_number\"=$1 AND \"counter_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, serialNumber, counterID)
+
+	err := q.Bind(ctx, exec, counterFrameObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from counter_frame")
+	}
+
+	if err = counterFrameObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return counterFrameObj, err
+	}
+
+	return counterFrameObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *CounterFrame) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CounterFrame) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no counter_frame provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(counterFrameColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	counterFrameInsertCacheMut.RLock()
+	cache, cached := counterFrameInsertCache[key]
+	counterFrameInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			counterFrameAllColumns,
+			counterFrameColumnsWithDefault,
+			counterFrameColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(counterFrameType, counterFrameMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(counterFrameType, counterFrameMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"xovis2\".\"counter_frame\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"xovis2\".\"counter_frame\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into counter_frame")
+	}
+
+	if !cached {
+		counterFrameInsertCacheMut.Lock()
+		counterFrameInsertCache[key] = cache
+		counterFrameInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single CounterFrame record using the global executor.
+// See Update for more documentation.
+func (o *CounterFrame) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the CounterFrame.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CounterFrame) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	counterFrameUpdateCacheMut.RLock()
+	cache, cached := counterFrameUpdateCache[key]
+	counterFrameUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			counterFrameAllColumns,
+			counterFramePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update counter_frame, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"xovis2\".\"counter_frame\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, counterFramePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(counterFrameType, counterFrameMapping, append(wl, counterFramePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update counter_frame row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for counter_frame")
+	}
+
+	if !cached {
+		counterFrameUpdateCacheMut.Lock()
+		counterFrameUpdateCache[key] = cache
+		counterFrameUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q counterFrameQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q counterFrameQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for counter_frame")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for counter_frame")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o CounterFrameSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CounterFrameSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), counterFramePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"xovis2\".\"counter_frame\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, counterFramePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in counterFrame slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all counterFrame")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *CounterFrame) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CounterFrame) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no counter_frame provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(counterFrameColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	counterFrameUpsertCacheMut.RLock()
+	cache, cached := counterFrameUpsertCache[key]
+	counterFrameUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			counterFrameAllColumns,
+			counterFrameColumnsWithDefault,
+			counterFrameColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			counterFrameAllColumns,
+			counterFramePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert counter_frame, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(counterFrameAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(counterFramePrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert counter_frame, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(counterFramePrimaryKeyColumns))
+			copy(conflict, counterFramePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"xovis2\".\"counter_frame\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(counterFrameType, counterFrameMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(counterFrameType, counterFrameMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert counter_frame")
+	}
+
+	if !cached {
+		counterFrameUpsertCacheMut.Lock()
+		counterFrameUpsertCache[key] = cache
+		counterFrameUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single CounterFrame record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *CounterFrame) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single CounterFrame record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CounterFrame) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no CounterFrame provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), counterFramePrimaryKeyMapping)
+	sql := "DELETE FROM \"xovis2\".\"counter_frame\" WHERE \"serial_number\"=$1 AND \"counter_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from counter_frame")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for counter_frame")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q counterFrameQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q counterFrameQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no counterFrameQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from counter_frame")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for counter_frame")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o CounterFrameSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CounterFrameSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(counterFrameBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), counterFramePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"xovis2\".\"counter_frame\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, counterFramePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from counterFrame slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for counter_frame")
+	}
+
+	if len(counterFrameAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *CounterFrame) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no CounterFrame provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CounterFrame) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCounterFrame(ctx, exec, o.SerialNumber, o.CounterID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CounterFrameSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty CounterFrameSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CounterFrameSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CounterFrameSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), counterFramePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"xovis2\".\"counter_frame\".* FROM \"xovis2\".\"counter_frame\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, counterFramePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in CounterFrameSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CounterFrameExistsG checks if the CounterFrame row exists.
+func CounterFrameExistsG(ctx context.Context, serialNumber string, counterID int32) (bool, error) {
+	return CounterFrameExists(ctx, boil.GetContextDB(), serialNumber, counterID)
+}
+
+// CounterFrameExists checks if the CounterFrame row exists.
+func CounterFrameExists(ctx context.Context, exec boil.ContextExecutor, serialNumber string, counterID int32) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"xovis2\".\"counter_frame\" where \"serial_number\"=$1 AND \"counter_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, serialNumber, counterID)
+	}
+	row := exec.QueryRowContext(ctx, sql, serialNumber, counterID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if counter_frame exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the CounterFrame row exists.
+func (o *CounterFrame) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CounterFrameExists(ctx, exec, o.SerialNumber, o.CounterID)
+}
@@ -0,0 +1,1287 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DatapushArchive is an object representing the database table.
+type DatapushArchive struct {
+	ID              int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID int64     `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	Source          string    `boil:"source" json:"source" toml:"source" yaml:"source"`
+	SerialNumber    string    `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	ContentType     string    `boil:"content_type" json:"content_type" toml:"content_type" yaml:"content_type"`
+	Body            []byte    `boil:"body" json:"body" toml:"body" yaml:"body"`
+	Size            int32     `boil:"size" json:"size" toml:"size" yaml:"size"`
+	ReceivedAt      time.Time `boil:"received_at" json:"received_at" toml:"received_at" yaml:"received_at"`
+
+	R *datapushArchiveR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L datapushArchiveL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DatapushArchiveColumns = struct {
+	ID              string
+	ConfigurationID string
+	Source          string
+	SerialNumber    string
+	ContentType     string
+	Body            string
+	Size            string
+	ReceivedAt      string
+}{
+	ID:              "id",
+	ConfigurationID: "configuration_id",
+	Source:          "source",
+	SerialNumber:    "serial_number",
+	ContentType:     "content_type",
+	Body:            "body",
+	Size:            "size",
+	ReceivedAt:      "received_at",
+}
+
+var DatapushArchiveTableColumns = struct {
+	ID              string
+	ConfigurationID string
+	Source          string
+	SerialNumber    string
+	ContentType     string
+	Body            string
+	Size            string
+	ReceivedAt      string
+}{
+	ID:              "datapush_archive.id",
+	ConfigurationID: "datapush_archive.configuration_id",
+	Source:          "datapush_archive.source",
+	SerialNumber:    "datapush_archive.serial_number",
+	ContentType:     "datapush_archive.content_type",
+	Body:            "datapush_archive.body",
+	Size:            "datapush_archive.size",
+	ReceivedAt:      "datapush_archive.received_at",
+}
+
+// Generated where
+
+type whereHelper__byte struct{ field string }
+
+func (w whereHelper__byte) EQ(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelper__byte) NEQ(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelper__byte) LT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelper__byte) LTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelper__byte) GT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelper__byte) GTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var DatapushArchiveWhere = struct {
+	ID              whereHelperint64
+	ConfigurationID whereHelperint64
+	Source          whereHelperstring
+	SerialNumber    whereHelperstring
+	ContentType     whereHelperstring
+	Body            whereHelper__byte
+	Size            whereHelperint32
+	ReceivedAt      whereHelpertime_Time
+}{
+	ID:              whereHelperint64{field: "\"xovis2\".\"datapush_archive\".\"id\""},
+	ConfigurationID: whereHelperint64{field: "\"xovis2\".\"datapush_archive\".\"configuration_id\""},
+	Source:          whereHelperstring{field: "\"xovis2\".\"datapush_archive\".\"source\""},
+	SerialNumber:    whereHelperstring{field: "\"xovis2\".\"datapush_archive\".\"serial_number\""},
+	ContentType:     whereHelperstring{field: "\"xovis2\".\"datapush_archive\".\"content_type\""},
+	Body:            whereHelper__byte{field: "\"xovis2\".\"datapush_archive\".\"body\""},
+	Size:            whereHelperint32{field: "\"xovis2\".\"datapush_archive\".\"size\""},
+	ReceivedAt:      whereHelpertime_Time{field: "\"xovis2\".\"datapush_archive\".\"received_at\""},
+}
+
+// DatapushArchiveRels is where relationship names are stored.
+var DatapushArchiveRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// datapushArchiveR is where relationships are stored.
+type datapushArchiveR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*datapushArchiveR) NewStruct() *datapushArchiveR {
+	return &datapushArchiveR{}
+}
+
+func (r *datapushArchiveR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// datapushArchiveL is where Load methods for each relationship are stored.
+type datapushArchiveL struct{}
+
+var (
+	datapushArchiveAllColumns            = []string{"id", "configuration_id", "source", "serial_number", "content_type", "body", "size", "received_at"}
+	datapushArchiveColumnsWithoutDefault = []string{"configuration_id", "source", "serial_number", "content_type", "body", "size"}
+	datapushArchiveColumnsWithDefault    = []string{"id", "received_at"}
+	datapushArchivePrimaryKeyColumns     = []string{"id"}
+	datapushArchiveGeneratedColumns      = []string{}
+)
+
+type (
+	// DatapushArchiveSlice is an alias for a slice of pointers to DatapushArchive.
+	// This should almost always be used instead of []DatapushArchive.
+	DatapushArchiveSlice []*DatapushArchive
+	// DatapushArchiveHook is the signature for custom DatapushArchive hook methods
+	DatapushArchiveHook func(context.Context, boil.ContextExecutor, *DatapushArchive) error
+
+	datapushArchiveQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	datapushArchiveType                 = reflect.TypeOf(&DatapushArchive{})
+	datapushArchiveMapping              = queries.MakeStructMapping(datapushArchiveType)
+	datapushArchivePrimaryKeyMapping, _ = queries.BindMapping(datapushArchiveType, datapushArchiveMapping, datapushArchivePrimaryKeyColumns)
+	datapushArchiveInsertCacheMut       sync.RWMutex
+	datapushArchiveInsertCache          = make(map[string]insertCache)
+	datapushArchiveUpdateCacheMut       sync.RWMutex
+	datapushArchiveUpdateCache          = make(map[string]updateCache)
+	datapushArchiveUpsertCacheMut       sync.RWMutex
+	datapushArchiveUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var datapushArchiveAfterSelectMu sync.Mutex
+var datapushArchiveAfterSelectHooks []DatapushArchiveHook
+
+var datapushArchiveBeforeInsertMu sync.Mutex
+var datapushArchiveBeforeInsertHooks []DatapushArchiveHook
+var datapushArchiveAfterInsertMu sync.Mutex
+var datapushArchiveAfterInsertHooks []DatapushArchiveHook
+
+var datapushArchiveBeforeUpdateMu sync.Mutex
+var datapushArchiveBeforeUpdateHooks []DatapushArchiveHook
+var datapushArchiveAfterUpdateMu sync.Mutex
+var datapushArchiveAfterUpdateHooks []DatapushArchiveHook
+
+var datapushArchiveBeforeDeleteMu sync.Mutex
+var datapushArchiveBeforeDeleteHooks []DatapushArchiveHook
+var datapushArchiveAfterDeleteMu sync.Mutex
+var datapushArchiveAfterDeleteHooks []DatapushArchiveHook
+
+var datapushArchiveBeforeUpsertMu sync.Mutex
+var datapushArchiveBeforeUpsertHooks []DatapushArchiveHook
+var datapushArchiveAfterUpsertMu sync.Mutex
+var datapushArchiveAfterUpsertHooks []DatapushArchiveHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DatapushArchive) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushArchiveAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DatapushArchive) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushArchiveBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DatapushArchive) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushArchiveAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DatapushArchive) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushArchiveBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DatapushArchive) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushArchiveAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DatapushArchive) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushArchiveBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DatapushArchive) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushArchiveAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DatapushArchive) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushArchiveBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DatapushArchive) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushArchiveAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDatapushArchiveHook registers your hook function for all future operations.
+func AddDatapushArchiveHook(hookPoint boil.HookPoint, datapushArchiveHook DatapushArchiveHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		datapushArchiveAfterSelectMu.Lock()
+		datapushArchiveAfterSelectHooks = append(datapushArchiveAfterSelectHooks, datapushArchiveHook)
+		datapushArchiveAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		datapushArchiveBeforeInsertMu.Lock()
+		datapushArchiveBeforeInsertHooks = append(datapushArchiveBeforeInsertHooks, datapushArchiveHook)
+		datapushArchiveBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		datapushArchiveAfterInsertMu.Lock()
+		datapushArchiveAfterInsertHooks = append(datapushArchiveAfterInsertHooks, datapushArchiveHook)
+		datapushArchiveAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		datapushArchiveBeforeUpdateMu.Lock()
+		datapushArchiveBeforeUpdateHooks = append(datapushArchiveBeforeUpdateHooks, datapushArchiveHook)
+		datapushArchiveBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		datapushArchiveAfterUpdateMu.Lock()
+		datapushArchiveAfterUpdateHooks = append(datapushArchiveAfterUpdateHooks, datapushArchiveHook)
+		datapushArchiveAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		datapushArchiveBeforeDeleteMu.Lock()
+		datapushArchiveBeforeDeleteHooks = append(datapushArchiveBeforeDeleteHooks, datapushArchiveHook)
+		datapushArchiveBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		datapushArchiveAfterDeleteMu.Lock()
+		datapushArchiveAfterDeleteHooks = append(datapushArchiveAfterDeleteHooks, datapushArchiveHook)
+		datapushArchiveAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		datapushArchiveBeforeUpsertMu.Lock()
+		datapushArchiveBeforeUpsertHooks = append(datapushArchiveBeforeUpsertHooks, datapushArchiveHook)
+		datapushArchiveBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		datapushArchiveAfterUpsertMu.Lock()
+		datapushArchiveAfterUpsertHooks = append(datapushArchiveAfterUpsertHooks, datapushArchiveHook)
+		datapushArchiveAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single datapushArchive record from the query using the global executor.
+func (q datapushArchiveQuery) OneG(ctx context.Context) (*DatapushArchive, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single datapushArchive record from the query.
+func (q datapushArchiveQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DatapushArchive, error) {
+	o := &DatapushArchive{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for datapush_archive")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all DatapushArchive records from the query using the global executor.
+func (q datapushArchiveQuery) AllG(ctx context.Context) (DatapushArchiveSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all DatapushArchive records from the query.
+func (q datapushArchiveQuery) All(ctx context.Context, exec boil.ContextExecutor) (DatapushArchiveSlice, error) {
+	var o []*DatapushArchive
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to DatapushArchive slice")
+	}
+
+	if len(datapushArchiveAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all DatapushArchive records in the query using the global executor
+func (q datapushArchiveQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all DatapushArchive records in the query.
+func (q datapushArchiveQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count datapush_archive rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q datapushArchiveQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q datapushArchiveQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if datapush_archive exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *DatapushArchive) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (datapushArchiveL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDatapushArchive interface{}, mods queries.Applicator) error {
+	var slice []*DatapushArchive
+	var object *DatapushArchive
+
+	if singular {
+		var ok bool
+		object, ok = maybeDatapushArchive.(*DatapushArchive)
+		if !ok {
+			object = new(DatapushArchive)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDatapushArchive)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDatapushArchive))
+			}
+		}
+	} else {
+		s, ok := maybeDatapushArchive.(*[]*DatapushArchive)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDatapushArchive)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDatapushArchive))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &datapushArchiveR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &datapushArchiveR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`xovis2.configuration`),
+		qm.WhereIn(`xovis2.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.DatapushArchives = append(foreign.R.DatapushArchives, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.DatapushArchives = append(foreign.R.DatapushArchives, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the datapushArchive to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.DatapushArchives.
+// Uses the global database handle.
+func (o *DatapushArchive) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the datapushArchive to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.DatapushArchives.
+func (o *DatapushArchive) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"xovis2\".\"datapush_archive\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, datapushArchivePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &datapushArchiveR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			DatapushArchives: DatapushArchiveSlice{o},
+		}
+	} else {
+		related.R.DatapushArchives = append(related.R.DatapushArchives, o)
+	}
+
+	return nil
+}
+
+// DatapushArchives retrieves all the records using an executor.
+func DatapushArchives(mods ...qm.QueryMod) datapushArchiveQuery {
+	mods = append(mods, qm.From("\"xovis2\".\"datapush_archive\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"xovis2\".\"datapush_archive\".*"})
+	}
+
+	return datapushArchiveQuery{q}
+}
+
+// FindDatapushArchiveG retrieves a single record by ID.
+func FindDatapushArchiveG(ctx context.Context, iD int64, selectCols ...string) (*DatapushArchive, error) {
+	return FindDatapushArchive(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindDatapushArchive retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDatapushArchive(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*DatapushArchive, error) {
+	datapushArchiveObj := &DatapushArchive{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"xovis2\".\"datapush_archive\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, datapushArchiveObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from datapush_archive")
+	}
+
+	if err = datapushArchiveObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return datapushArchiveObj, err
+	}
+
+	return datapushArchiveObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *DatapushArchive) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DatapushArchive) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no datapush_archive provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(datapushArchiveColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	datapushArchiveInsertCacheMut.RLock()
+	cache, cached := datapushArchiveInsertCache[key]
+	datapushArchiveInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			datapushArchiveAllColumns,
+			datapushArchiveColumnsWithDefault,
+			datapushArchiveColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(datapushArchiveType, datapushArchiveMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(datapushArchiveType, datapushArchiveMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"xovis2\".\"datapush_archive\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"xovis2\".\"datapush_archive\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into datapush_archive")
+	}
+
+	if !cached {
+		datapushArchiveInsertCacheMut.Lock()
+		datapushArchiveInsertCache[key] = cache
+		datapushArchiveInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single DatapushArchive record using the global executor.
+// See Update for more documentation.
+func (o *DatapushArchive) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the DatapushArchive.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DatapushArchive) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	datapushArchiveUpdateCacheMut.RLock()
+	cache, cached := datapushArchiveUpdateCache[key]
+	datapushArchiveUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			datapushArchiveAllColumns,
+			datapushArchivePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update datapush_archive, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"xovis2\".\"datapush_archive\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, datapushArchivePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(datapushArchiveType, datapushArchiveMapping, append(wl, datapushArchivePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update datapush_archive row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for datapush_archive")
+	}
+
+	if !cached {
+		datapushArchiveUpdateCacheMut.Lock()
+		datapushArchiveUpdateCache[key] = cache
+		datapushArchiveUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q datapushArchiveQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q datapushArchiveQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for datapush_archive")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for datapush_archive")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o DatapushArchiveSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DatapushArchiveSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapushArchivePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"xovis2\".\"datapush_archive\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, datapushArchivePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in datapushArchive slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all datapushArchive")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *DatapushArchive) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DatapushArchive) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no datapush_archive provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(datapushArchiveColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	datapushArchiveUpsertCacheMut.RLock()
+	cache, cached := datapushArchiveUpsertCache[key]
+	datapushArchiveUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			datapushArchiveAllColumns,
+			datapushArchiveColumnsWithDefault,
+			datapushArchiveColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			datapushArchiveAllColumns,
+			datapushArchivePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert datapush_archive, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(datapushArchiveAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(datapushArchivePrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert datapush_archive, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(datapushArchivePrimaryKeyColumns))
+			copy(conflict, datapushArchivePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"xovis2\".\"datapush_archive\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(datapushArchiveType, datapushArchiveMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(datapushArchiveType, datapushArchiveMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert datapush_archive")
+	}
+
+	if !cached {
+		datapushArchiveUpsertCacheMut.Lock()
+		datapushArchiveUpsertCache[key] = cache
+		datapushArchiveUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single DatapushArchive record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *DatapushArchive) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single DatapushArchive record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DatapushArchive) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no DatapushArchive provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), datapushArchivePrimaryKeyMapping)
+	sql := "DELETE FROM \"xovis2\".\"datapush_archive\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from datapush_archive")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for datapush_archive")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q datapushArchiveQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q datapushArchiveQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no datapushArchiveQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from datapush_archive")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for datapush_archive")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o DatapushArchiveSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DatapushArchiveSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(datapushArchiveBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapushArchivePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"xovis2\".\"datapush_archive\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, datapushArchivePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from datapushArchive slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for datapush_archive")
+	}
+
+	if len(datapushArchiveAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *DatapushArchive) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no DatapushArchive provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DatapushArchive) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDatapushArchive(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DatapushArchiveSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty DatapushArchiveSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DatapushArchiveSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DatapushArchiveSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapushArchivePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"xovis2\".\"datapush_archive\".* FROM \"xovis2\".\"datapush_archive\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, datapushArchivePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in DatapushArchiveSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DatapushArchiveExistsG checks if the DatapushArchive row exists.
+func DatapushArchiveExistsG(ctx context.Context, iD int64) (bool, error) {
+	return DatapushArchiveExists(ctx, boil.GetContextDB(), iD)
+}
+
+// DatapushArchiveExists checks if the DatapushArchive row exists.
+func DatapushArchiveExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"xovis2\".\"datapush_archive\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if datapush_archive exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DatapushArchive row exists.
+func (o *DatapushArchive) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DatapushArchiveExists(ctx, exec, o.ID)
+}
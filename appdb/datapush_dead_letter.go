@@ -0,0 +1,1096 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// DatapushDeadLetter is an object representing the database table.
+type DatapushDeadLetter struct {
+	ID                 int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID    int64      `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	Source             string     `boil:"source" json:"source" toml:"source" yaml:"source"`
+	SerialNumber       string     `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	Payload            types.JSON `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Attempts           int32      `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	LastError          string     `boil:"last_error" json:"last_error" toml:"last_error" yaml:"last_error"`
+	ReceivedAt         time.Time  `boil:"received_at" json:"received_at" toml:"received_at" yaml:"received_at"`
+	FailedAt           time.Time  `boil:"failed_at" json:"failed_at" toml:"failed_at" yaml:"failed_at"`
+	SideEffectsApplied bool       `boil:"side_effects_applied" json:"side_effects_applied" toml:"side_effects_applied" yaml:"side_effects_applied"`
+
+	R *datapushDeadLetterR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L datapushDeadLetterL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DatapushDeadLetterColumns = struct {
+	ID                 string
+	ConfigurationID    string
+	Source             string
+	SerialNumber       string
+	Payload            string
+	Attempts           string
+	LastError          string
+	ReceivedAt         string
+	FailedAt           string
+	SideEffectsApplied string
+}{
+	ID:                 "id",
+	ConfigurationID:    "configuration_id",
+	Source:             "source",
+	SerialNumber:       "serial_number",
+	Payload:            "payload",
+	Attempts:           "attempts",
+	LastError:          "last_error",
+	ReceivedAt:         "received_at",
+	FailedAt:           "failed_at",
+	SideEffectsApplied: "side_effects_applied",
+}
+
+var DatapushDeadLetterTableColumns = struct {
+	ID                 string
+	ConfigurationID    string
+	Source             string
+	SerialNumber       string
+	Payload            string
+	Attempts           string
+	LastError          string
+	ReceivedAt         string
+	FailedAt           string
+	SideEffectsApplied string
+}{
+	ID:                 "datapush_dead_letter.id",
+	ConfigurationID:    "datapush_dead_letter.configuration_id",
+	Source:             "datapush_dead_letter.source",
+	SerialNumber:       "datapush_dead_letter.serial_number",
+	Payload:            "datapush_dead_letter.payload",
+	Attempts:           "datapush_dead_letter.attempts",
+	LastError:          "datapush_dead_letter.last_error",
+	ReceivedAt:         "datapush_dead_letter.received_at",
+	FailedAt:           "datapush_dead_letter.failed_at",
+	SideEffectsApplied: "datapush_dead_letter.side_effects_applied",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var DatapushDeadLetterWhere = struct {
+	ID                 whereHelperint64
+	ConfigurationID    whereHelperint64
+	Source             whereHelperstring
+	SerialNumber       whereHelperstring
+	Payload            whereHelpertypes_JSON
+	Attempts           whereHelperint32
+	LastError          whereHelperstring
+	ReceivedAt         whereHelpertime_Time
+	FailedAt           whereHelpertime_Time
+	SideEffectsApplied whereHelperbool
+}{
+	ID:                 whereHelperint64{field: "\"xovis2\".\"datapush_dead_letter\".\"id\""},
+	ConfigurationID:    whereHelperint64{field: "\"xovis2\".\"datapush_dead_letter\".\"configuration_id\""},
+	Source:             whereHelperstring{field: "\"xovis2\".\"datapush_dead_letter\".\"source\""},
+	SerialNumber:       whereHelperstring{field: "\"xovis2\".\"datapush_dead_letter\".\"serial_number\""},
+	Payload:            whereHelpertypes_JSON{field: "\"xovis2\".\"datapush_dead_letter\".\"payload\""},
+	Attempts:           whereHelperint32{field: "\"xovis2\".\"datapush_dead_letter\".\"attempts\""},
+	LastError:          whereHelperstring{field: "\"xovis2\".\"datapush_dead_letter\".\"last_error\""},
+	ReceivedAt:         whereHelpertime_Time{field: "\"xovis2\".\"datapush_dead_letter\".\"received_at\""},
+	FailedAt:           whereHelpertime_Time{field: "\"xovis2\".\"datapush_dead_letter\".\"failed_at\""},
+	SideEffectsApplied: whereHelperbool{field: "\"xovis2\".\"datapush_dead_letter\".\"side_effects_applied\""},
+}
+
+// DatapushDeadLetterRels is where relationship names are stored.
+var DatapushDeadLetterRels = struct {
+}{}
+
+// datapushDeadLetterR is where relationships are stored.
+type datapushDeadLetterR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*datapushDeadLetterR) NewStruct() *datapushDeadLetterR {
+	return &datapushDeadLetterR{}
+}
+
+// datapushDeadLetterL is where Load methods for each relationship are stored.
+type datapushDeadLetterL struct{}
+
+var (
+	datapushDeadLetterAllColumns            = []string{"id", "configuration_id", "source", "serial_number", "payload", "attempts", "last_error", "received_at", "failed_at", "side_effects_applied"}
+	datapushDeadLetterColumnsWithoutDefault = []string{"configuration_id", "source", "serial_number", "payload", "attempts", "last_error", "received_at"}
+	datapushDeadLetterColumnsWithDefault    = []string{"id", "failed_at", "side_effects_applied"}
+	datapushDeadLetterPrimaryKeyColumns     = []string{"id"}
+	datapushDeadLetterGeneratedColumns      = []string{}
+)
+
+type (
+	// DatapushDeadLetterSlice is an alias for a slice of pointers to DatapushDeadLetter.
+	// This should almost always be used instead of []DatapushDeadLetter.
+	DatapushDeadLetterSlice []*DatapushDeadLetter
+	// DatapushDeadLetterHook is the signature for custom DatapushDeadLetter hook methods
+	DatapushDeadLetterHook func(context.Context, boil.ContextExecutor, *DatapushDeadLetter) error
+
+	datapushDeadLetterQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	datapushDeadLetterType                 = reflect.TypeOf(&DatapushDeadLetter{})
+	datapushDeadLetterMapping              = queries.MakeStructMapping(datapushDeadLetterType)
+	datapushDeadLetterPrimaryKeyMapping, _ = queries.BindMapping(datapushDeadLetterType, datapushDeadLetterMapping, datapushDeadLetterPrimaryKeyColumns)
+	datapushDeadLetterInsertCacheMut       sync.RWMutex
+	datapushDeadLetterInsertCache          = make(map[string]insertCache)
+	datapushDeadLetterUpdateCacheMut       sync.RWMutex
+	datapushDeadLetterUpdateCache          = make(map[string]updateCache)
+	datapushDeadLetterUpsertCacheMut       sync.RWMutex
+	datapushDeadLetterUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var datapushDeadLetterAfterSelectMu sync.Mutex
+var datapushDeadLetterAfterSelectHooks []DatapushDeadLetterHook
+
+var datapushDeadLetterBeforeInsertMu sync.Mutex
+var datapushDeadLetterBeforeInsertHooks []DatapushDeadLetterHook
+var datapushDeadLetterAfterInsertMu sync.Mutex
+var datapushDeadLetterAfterInsertHooks []DatapushDeadLetterHook
+
+var datapushDeadLetterBeforeUpdateMu sync.Mutex
+var datapushDeadLetterBeforeUpdateHooks []DatapushDeadLetterHook
+var datapushDeadLetterAfterUpdateMu sync.Mutex
+var datapushDeadLetterAfterUpdateHooks []DatapushDeadLetterHook
+
+var datapushDeadLetterBeforeDeleteMu sync.Mutex
+var datapushDeadLetterBeforeDeleteHooks []DatapushDeadLetterHook
+var datapushDeadLetterAfterDeleteMu sync.Mutex
+var datapushDeadLetterAfterDeleteHooks []DatapushDeadLetterHook
+
+var datapushDeadLetterBeforeUpsertMu sync.Mutex
+var datapushDeadLetterBeforeUpsertHooks []DatapushDeadLetterHook
+var datapushDeadLetterAfterUpsertMu sync.Mutex
+var datapushDeadLetterAfterUpsertHooks []DatapushDeadLetterHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DatapushDeadLetter) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushDeadLetterAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DatapushDeadLetter) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushDeadLetterBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DatapushDeadLetter) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushDeadLetterAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DatapushDeadLetter) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushDeadLetterBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DatapushDeadLetter) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushDeadLetterAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DatapushDeadLetter) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushDeadLetterBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DatapushDeadLetter) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushDeadLetterAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DatapushDeadLetter) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushDeadLetterBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DatapushDeadLetter) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushDeadLetterAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDatapushDeadLetterHook registers your hook function for all future operations.
+func AddDatapushDeadLetterHook(hookPoint boil.HookPoint, datapushDeadLetterHook DatapushDeadLetterHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		datapushDeadLetterAfterSelectMu.Lock()
+		datapushDeadLetterAfterSelectHooks = append(datapushDeadLetterAfterSelectHooks, datapushDeadLetterHook)
+		datapushDeadLetterAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		datapushDeadLetterBeforeInsertMu.Lock()
+		datapushDeadLetterBeforeInsertHooks = append(datapushDeadLetterBeforeInsertHooks, datapushDeadLetterHook)
+		datapushDeadLetterBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		datapushDeadLetterAfterInsertMu.Lock()
+		datapushDeadLetterAfterInsertHooks = append(datapushDeadLetterAfterInsertHooks, datapushDeadLetterHook)
+		datapushDeadLetterAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		datapushDeadLetterBeforeUpdateMu.Lock()
+		datapushDeadLetterBeforeUpdateHooks = append(datapushDeadLetterBeforeUpdateHooks, datapushDeadLetterHook)
+		datapushDeadLetterBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		datapushDeadLetterAfterUpdateMu.Lock()
+		datapushDeadLetterAfterUpdateHooks = append(datapushDeadLetterAfterUpdateHooks, datapushDeadLetterHook)
+		datapushDeadLetterAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		datapushDeadLetterBeforeDeleteMu.Lock()
+		datapushDeadLetterBeforeDeleteHooks = append(datapushDeadLetterBeforeDeleteHooks, datapushDeadLetterHook)
+		datapushDeadLetterBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		datapushDeadLetterAfterDeleteMu.Lock()
+		datapushDeadLetterAfterDeleteHooks = append(datapushDeadLetterAfterDeleteHooks, datapushDeadLetterHook)
+		datapushDeadLetterAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		datapushDeadLetterBeforeUpsertMu.Lock()
+		datapushDeadLetterBeforeUpsertHooks = append(datapushDeadLetterBeforeUpsertHooks, datapushDeadLetterHook)
+		datapushDeadLetterBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		datapushDeadLetterAfterUpsertMu.Lock()
+		datapushDeadLetterAfterUpsertHooks = append(datapushDeadLetterAfterUpsertHooks, datapushDeadLetterHook)
+		datapushDeadLetterAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single datapushDeadLetter record from the query using the global executor.
+func (q datapushDeadLetterQuery) OneG(ctx context.Context) (*DatapushDeadLetter, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single datapushDeadLetter record from the query.
+func (q datapushDeadLetterQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DatapushDeadLetter, error) {
+	o := &DatapushDeadLetter{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for datapush_dead_letter")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all DatapushDeadLetter records from the query using the global executor.
+func (q datapushDeadLetterQuery) AllG(ctx context.Context) (DatapushDeadLetterSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all DatapushDeadLetter records from the query.
+func (q datapushDeadLetterQuery) All(ctx context.Context, exec boil.ContextExecutor) (DatapushDeadLetterSlice, error) {
+	var o []*DatapushDeadLetter
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to DatapushDeadLetter slice")
+	}
+
+	if len(datapushDeadLetterAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all DatapushDeadLetter records in the query using the global executor
+func (q datapushDeadLetterQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all DatapushDeadLetter records in the query.
+func (q datapushDeadLetterQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count datapush_dead_letter rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q datapushDeadLetterQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q datapushDeadLetterQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if datapush_dead_letter exists")
+	}
+
+	return count > 0, nil
+}
+
+// DatapushDeadLetters retrieves all the records using an executor.
+func DatapushDeadLetters(mods ...qm.QueryMod) datapushDeadLetterQuery {
+	mods = append(mods, qm.From("\"xovis2\".\"datapush_dead_letter\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"xovis2\".\"datapush_dead_letter\".*"})
+	}
+
+	return datapushDeadLetterQuery{q}
+}
+
+// FindDatapushDeadLetterG retrieves a single record by ID.
+func FindDatapushDeadLetterG(ctx context.Context, iD int64, selectCols ...string) (*DatapushDeadLetter, error) {
+	return FindDatapushDeadLetter(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindDatapushDeadLetter retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDatapushDeadLetter(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*DatapushDeadLetter, error) {
+	datapushDeadLetterObj := &DatapushDeadLetter{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"xovis2\".\"datapush_dead_letter\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, datapushDeadLetterObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from datapush_dead_letter")
+	}
+
+	if err = datapushDeadLetterObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return datapushDeadLetterObj, err
+	}
+
+	return datapushDeadLetterObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *DatapushDeadLetter) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DatapushDeadLetter) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no datapush_dead_letter provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(datapushDeadLetterColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	datapushDeadLetterInsertCacheMut.RLock()
+	cache, cached := datapushDeadLetterInsertCache[key]
+	datapushDeadLetterInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			datapushDeadLetterAllColumns,
+			datapushDeadLetterColumnsWithDefault,
+			datapushDeadLetterColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(datapushDeadLetterType, datapushDeadLetterMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(datapushDeadLetterType, datapushDeadLetterMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"xovis2\".\"datapush_dead_letter\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"xovis2\".\"datapush_dead_letter\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into datapush_dead_letter")
+	}
+
+	if !cached {
+		datapushDeadLetterInsertCacheMut.Lock()
+		datapushDeadLetterInsertCache[key] = cache
+		datapushDeadLetterInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single DatapushDeadLetter record using the global executor.
+// See Update for more documentation.
+func (o *DatapushDeadLetter) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the DatapushDeadLetter.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DatapushDeadLetter) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	datapushDeadLetterUpdateCacheMut.RLock()
+	cache, cached := datapushDeadLetterUpdateCache[key]
+	datapushDeadLetterUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			datapushDeadLetterAllColumns,
+			datapushDeadLetterPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update datapush_dead_letter, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"xovis2\".\"datapush_dead_letter\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, datapushDeadLetterPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(datapushDeadLetterType, datapushDeadLetterMapping, append(wl, datapushDeadLetterPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update datapush_dead_letter row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for datapush_dead_letter")
+	}
+
+	if !cached {
+		datapushDeadLetterUpdateCacheMut.Lock()
+		datapushDeadLetterUpdateCache[key] = cache
+		datapushDeadLetterUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q datapushDeadLetterQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q datapushDeadLetterQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for datapush_dead_letter")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for datapush_dead_letter")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o DatapushDeadLetterSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DatapushDeadLetterSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapushDeadLetterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"xovis2\".\"datapush_dead_letter\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, datapushDeadLetterPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in datapushDeadLetter slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all datapushDeadLetter")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *DatapushDeadLetter) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DatapushDeadLetter) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no datapush_dead_letter provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(datapushDeadLetterColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	datapushDeadLetterUpsertCacheMut.RLock()
+	cache, cached := datapushDeadLetterUpsertCache[key]
+	datapushDeadLetterUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			datapushDeadLetterAllColumns,
+			datapushDeadLetterColumnsWithDefault,
+			datapushDeadLetterColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			datapushDeadLetterAllColumns,
+			datapushDeadLetterPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert datapush_dead_letter, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(datapushDeadLetterAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(datapushDeadLetterPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert datapush_dead_letter, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(datapushDeadLetterPrimaryKeyColumns))
+			copy(conflict, datapushDeadLetterPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"xovis2\".\"datapush_dead_letter\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(datapushDeadLetterType, datapushDeadLetterMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(datapushDeadLetterType, datapushDeadLetterMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert datapush_dead_letter")
+	}
+
+	if !cached {
+		datapushDeadLetterUpsertCacheMut.Lock()
+		datapushDeadLetterUpsertCache[key] = cache
+		datapushDeadLetterUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single DatapushDeadLetter record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *DatapushDeadLetter) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single DatapushDeadLetter record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DatapushDeadLetter) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no DatapushDeadLetter provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), datapushDeadLetterPrimaryKeyMapping)
+	sql := "DELETE FROM \"xovis2\".\"datapush_dead_letter\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from datapush_dead_letter")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for datapush_dead_letter")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q datapushDeadLetterQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q datapushDeadLetterQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no datapushDeadLetterQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from datapush_dead_letter")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for datapush_dead_letter")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o DatapushDeadLetterSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DatapushDeadLetterSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(datapushDeadLetterBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapushDeadLetterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"xovis2\".\"datapush_dead_letter\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, datapushDeadLetterPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from datapushDeadLetter slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for datapush_dead_letter")
+	}
+
+	if len(datapushDeadLetterAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *DatapushDeadLetter) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no DatapushDeadLetter provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DatapushDeadLetter) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDatapushDeadLetter(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DatapushDeadLetterSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty DatapushDeadLetterSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DatapushDeadLetterSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DatapushDeadLetterSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapushDeadLetterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"xovis2\".\"datapush_dead_letter\".* FROM \"xovis2\".\"datapush_dead_letter\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, datapushDeadLetterPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in DatapushDeadLetterSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DatapushDeadLetterExistsG checks if the DatapushDeadLetter row exists.
+func DatapushDeadLetterExistsG(ctx context.Context, iD int64) (bool, error) {
+	return DatapushDeadLetterExists(ctx, boil.GetContextDB(), iD)
+}
+
+// DatapushDeadLetterExists checks if the DatapushDeadLetter row exists.
+func DatapushDeadLetterExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"xovis2\".\"datapush_dead_letter\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if datapush_dead_letter exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DatapushDeadLetter row exists.
+func (o *DatapushDeadLetter) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DatapushDeadLetterExists(ctx, exec, o.ID)
+}
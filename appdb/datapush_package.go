@@ -0,0 +1,1039 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DatapushPackage is an object representing the database table.
+type DatapushPackage struct {
+	SerialNumber string    `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	AgentID      int32     `boil:"agent_id" json:"agent_id" toml:"agent_id" yaml:"agent_id"`
+	PackageID    int64     `boil:"package_id" json:"package_id" toml:"package_id" yaml:"package_id"`
+	FirstFrame   int64     `boil:"first_frame" json:"first_frame" toml:"first_frame" yaml:"first_frame"`
+	ReceivedAt   time.Time `boil:"received_at" json:"received_at" toml:"received_at" yaml:"received_at"`
+
+	R *datapushPackageR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L datapushPackageL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DatapushPackageColumns = struct {
+	SerialNumber string
+	AgentID      string
+	PackageID    string
+	FirstFrame   string
+	ReceivedAt   string
+}{
+	SerialNumber: "serial_number",
+	AgentID:      "agent_id",
+	PackageID:    "package_id",
+	FirstFrame:   "first_frame",
+	ReceivedAt:   "received_at",
+}
+
+var DatapushPackageTableColumns = struct {
+	SerialNumber string
+	AgentID      string
+	PackageID    string
+	FirstFrame   string
+	ReceivedAt   string
+}{
+	SerialNumber: "datapush_package.serial_number",
+	AgentID:      "datapush_package.agent_id",
+	PackageID:    "datapush_package.package_id",
+	FirstFrame:   "datapush_package.first_frame",
+	ReceivedAt:   "datapush_package.received_at",
+}
+
+// Generated where
+
+var DatapushPackageWhere = struct {
+	SerialNumber whereHelperstring
+	AgentID      whereHelperint32
+	PackageID    whereHelperint64
+	FirstFrame   whereHelperint64
+	ReceivedAt   whereHelpertime_Time
+}{
+	SerialNumber: whereHelperstring{field: "\"xovis2\".\"datapush_package\".\"serial_number\""},
+	AgentID:      whereHelperint32{field: "\"xovis2\".\"datapush_package\".\"agent_id\""},
+	PackageID:    whereHelperint64{field: "\"xovis2\".\"datapush_package\".\"package_id\""},
+	FirstFrame:   whereHelperint64{field: "\"xovis2\".\"datapush_package\".\"first_frame\""},
+	ReceivedAt:   whereHelpertime_Time{field: "\"xovis2\".\"datapush_package\".\"received_at\""},
+}
+
+// DatapushPackageRels is where relationship names are stored.
+var DatapushPackageRels = struct {
+}{}
+
+// datapushPackageR is where relationships are stored.
+type datapushPackageR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*datapushPackageR) NewStruct() *datapushPackageR {
+	return &datapushPackageR{}
+}
+
+// datapushPackageL is where Load methods for each relationship are stored.
+type datapushPackageL struct{}
+
+var (
+	datapushPackageAllColumns            = []string{"serial_number", "agent_id", "package_id", "first_frame", "received_at"}
+	datapushPackageColumnsWithoutDefault = []string{"serial_number", "agent_id", "package_id", "first_frame"}
+	datapushPackageColumnsWithDefault    = []string{"received_at"}
+	datapushPackagePrimaryKeyColumns     = []string{"serial_number", "agent_id", "package_id", "first_frame"}
+	datapushPackageGeneratedColumns      = []string{}
+)
+
+type (
+	// DatapushPackageSlice is an alias for a slice of pointers to DatapushPackage.
+	// This should almost always be used instead of []DatapushPackage.
+	DatapushPackageSlice []*DatapushPackage
+	// DatapushPackageHook is the signature for custom DatapushPackage hook methods
+	DatapushPackageHook func(context.Context, boil.ContextExecutor, *DatapushPackage) error
+
+	datapushPackageQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	datapushPackageType                 = reflect.TypeOf(&DatapushPackage{})
+	datapushPackageMapping              = queries.MakeStructMapping(datapushPackageType)
+	datapushPackagePrimaryKeyMapping, _ = queries.BindMapping(datapushPackageType, datapushPackageMapping, datapushPackagePrimaryKeyColumns)
+	datapushPackageInsertCacheMut       sync.RWMutex
+	datapushPackageInsertCache          = make(map[string]insertCache)
+	datapushPackageUpdateCacheMut       sync.RWMutex
+	datapushPackageUpdateCache          = make(map[string]updateCache)
+	datapushPackageUpsertCacheMut       sync.RWMutex
+	datapushPackageUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var datapushPackageAfterSelectMu sync.Mutex
+var datapushPackageAfterSelectHooks []DatapushPackageHook
+
+var datapushPackageBeforeInsertMu sync.Mutex
+var datapushPackageBeforeInsertHooks []DatapushPackageHook
+var datapushPackageAfterInsertMu sync.Mutex
+var datapushPackageAfterInsertHooks []DatapushPackageHook
+
+var datapushPackageBeforeUpdateMu sync.Mutex
+var datapushPackageBeforeUpdateHooks []DatapushPackageHook
+var datapushPackageAfterUpdateMu sync.Mutex
+var datapushPackageAfterUpdateHooks []DatapushPackageHook
+
+var datapushPackageBeforeDeleteMu sync.Mutex
+var datapushPackageBeforeDeleteHooks []DatapushPackageHook
+var datapushPackageAfterDeleteMu sync.Mutex
+var datapushPackageAfterDeleteHooks []DatapushPackageHook
+
+var datapushPackageBeforeUpsertMu sync.Mutex
+var datapushPackageBeforeUpsertHooks []DatapushPackageHook
+var datapushPackageAfterUpsertMu sync.Mutex
+var datapushPackageAfterUpsertHooks []DatapushPackageHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DatapushPackage) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushPackageAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DatapushPackage) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushPackageBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DatapushPackage) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushPackageAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DatapushPackage) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushPackageBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DatapushPackage) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushPackageAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DatapushPackage) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushPackageBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DatapushPackage) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushPackageAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DatapushPackage) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushPackageBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DatapushPackage) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushPackageAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDatapushPackageHook registers your hook function for all future operations.
+func AddDatapushPackageHook(hookPoint boil.HookPoint, datapushPackageHook DatapushPackageHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		datapushPackageAfterSelectMu.Lock()
+		datapushPackageAfterSelectHooks = append(datapushPackageAfterSelectHooks, datapushPackageHook)
+		datapushPackageAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		datapushPackageBeforeInsertMu.Lock()
+		datapushPackageBeforeInsertHooks = append(datapushPackageBeforeInsertHooks, datapushPackageHook)
+		datapushPackageBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		datapushPackageAfterInsertMu.Lock()
+		datapushPackageAfterInsertHooks = append(datapushPackageAfterInsertHooks, datapushPackageHook)
+		datapushPackageAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		datapushPackageBeforeUpdateMu.Lock()
+		datapushPackageBeforeUpdateHooks = append(datapushPackageBeforeUpdateHooks, datapushPackageHook)
+		datapushPackageBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		datapushPackageAfterUpdateMu.Lock()
+		datapushPackageAfterUpdateHooks = append(datapushPackageAfterUpdateHooks, datapushPackageHook)
+		datapushPackageAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		datapushPackageBeforeDeleteMu.Lock()
+		datapushPackageBeforeDeleteHooks = append(datapushPackageBeforeDeleteHooks, datapushPackageHook)
+		datapushPackageBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		datapushPackageAfterDeleteMu.Lock()
+		datapushPackageAfterDeleteHooks = append(datapushPackageAfterDeleteHooks, datapushPackageHook)
+		datapushPackageAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		datapushPackageBeforeUpsertMu.Lock()
+		datapushPackageBeforeUpsertHooks = append(datapushPackageBeforeUpsertHooks, datapushPackageHook)
+		datapushPackageBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		datapushPackageAfterUpsertMu.Lock()
+		datapushPackageAfterUpsertHooks = append(datapushPackageAfterUpsertHooks, datapushPackageHook)
+		datapushPackageAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single datapushPackage record from the query using the global executor.
+func (q datapushPackageQuery) OneG(ctx context.Context) (*DatapushPackage, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single datapushPackage record from the query.
+func (q datapushPackageQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DatapushPackage, error) {
+	o := &DatapushPackage{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for datapush_package")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all DatapushPackage records from the query using the global executor.
+func (q datapushPackageQuery) AllG(ctx context.Context) (DatapushPackageSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all DatapushPackage records from the query.
+func (q datapushPackageQuery) All(ctx context.Context, exec boil.ContextExecutor) (DatapushPackageSlice, error) {
+	var o []*DatapushPackage
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to DatapushPackage slice")
+	}
+
+	if len(datapushPackageAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all DatapushPackage records in the query using the global executor
+func (q datapushPackageQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all DatapushPackage records in the query.
+func (q datapushPackageQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count datapush_package rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q datapushPackageQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q datapushPackageQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if datapush_package exists")
+	}
+
+	return count > 0, nil
+}
+
+// DatapushPackages retrieves all the records using an executor.
+func DatapushPackages(mods ...qm.QueryMod) datapushPackageQuery {
+	mods = append(mods, qm.From("\"xovis2\".\"datapush_package\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"xovis2\".\"datapush_package\".*"})
+	}
+
+	return datapushPackageQuery{q}
+}
+
+// FindDatapushPackageG retrieves a single record by ID.
+func FindDatapushPackageG(ctx context.Context, serialNumber string, agentID int32, packageID int64, firstFrame int64, selectCols ...string) (*DatapushPackage, error) {
+	return FindDatapushPackage(ctx, boil.GetContextDB(), serialNumber, agentID, packageID, firstFrame, selectCols...)
+}
+
+// FindDatapushPackage retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDatapushPackage(ctx context.Context, exec boil.ContextExecutor, serialNumber string, agentID int32, packageID int64, firstFrame int64, selectCols ...string) (*DatapushPackage, error) {
+	datapushPackageObj := &DatapushPackage{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"xovis2\".\"datapush_package\" where \"serial_number\"=$1 AND \"agent_id\"=$2 AND \"package_id\"=$3 AND \"first_frame\"=$4", sel,
+	)
+
+	q := queries.Raw(query, serialNumber, agentID, packageID, firstFrame)
+
+	err := q.Bind(ctx, exec, datapushPackageObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from datapush_package")
+	}
+
+	if err = datapushPackageObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return datapushPackageObj, err
+	}
+
+	return datapushPackageObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *DatapushPackage) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DatapushPackage) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no datapush_package provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(datapushPackageColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	datapushPackageInsertCacheMut.RLock()
+	cache, cached := datapushPackageInsertCache[key]
+	datapushPackageInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			datapushPackageAllColumns,
+			datapushPackageColumnsWithDefault,
+			datapushPackageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(datapushPackageType, datapushPackageMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(datapushPackageType, datapushPackageMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"xovis2\".\"datapush_package\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"xovis2\".\"datapush_package\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into datapush_package")
+	}
+
+	if !cached {
+		datapushPackageInsertCacheMut.Lock()
+		datapushPackageInsertCache[key] = cache
+		datapushPackageInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single DatapushPackage record using the global executor.
+// See Update for more documentation.
+func (o *DatapushPackage) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the DatapushPackage.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DatapushPackage) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	datapushPackageUpdateCacheMut.RLock()
+	cache, cached := datapushPackageUpdateCache[key]
+	datapushPackageUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			datapushPackageAllColumns,
+			datapushPackagePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update datapush_package, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"xovis2\".\"datapush_package\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, datapushPackagePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(datapushPackageType, datapushPackageMapping, append(wl, datapushPackagePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update datapush_package row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for datapush_package")
+	}
+
+	if !cached {
+		datapushPackageUpdateCacheMut.Lock()
+		datapushPackageUpdateCache[key] = cache
+		datapushPackageUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q datapushPackageQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q datapushPackageQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for datapush_package")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for datapush_package")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o DatapushPackageSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DatapushPackageSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapushPackagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"xovis2\".\"datapush_package\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, datapushPackagePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in datapushPackage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all datapushPackage")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *DatapushPackage) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DatapushPackage) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no datapush_package provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(datapushPackageColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	datapushPackageUpsertCacheMut.RLock()
+	cache, cached := datapushPackageUpsertCache[key]
+	datapushPackageUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			datapushPackageAllColumns,
+			datapushPackageColumnsWithDefault,
+			datapushPackageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			datapushPackageAllColumns,
+			datapushPackagePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert datapush_package, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(datapushPackageAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(datapushPackagePrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert datapush_package, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(datapushPackagePrimaryKeyColumns))
+			copy(conflict, datapushPackagePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"xovis2\".\"datapush_package\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(datapushPackageType, datapushPackageMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(datapushPackageType, datapushPackageMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert datapush_package")
+	}
+
+	if !cached {
+		datapushPackageUpsertCacheMut.Lock()
+		datapushPackageUpsertCache[key] = cache
+		datapushPackageUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single DatapushPackage record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *DatapushPackage) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single DatapushPackage record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DatapushPackage) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no DatapushPackage provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), datapushPackagePrimaryKeyMapping)
+	sql := "DELETE FROM \"xovis2\".\"datapush_package\" WHERE \"serial_number\"=$1 AND \"agent_id\"=$2 AND \"package_id\"=$3 AND \"first_frame\"=$4"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from datapush_package")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for datapush_package")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q datapushPackageQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q datapushPackageQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no datapushPackageQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from datapush_package")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for datapush_package")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o DatapushPackageSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DatapushPackageSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(datapushPackageBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapushPackagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"xovis2\".\"datapush_package\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, datapushPackagePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from datapushPackage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for datapush_package")
+	}
+
+	if len(datapushPackageAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *DatapushPackage) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no DatapushPackage provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DatapushPackage) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDatapushPackage(ctx, exec, o.SerialNumber, o.AgentID, o.PackageID, o.FirstFrame)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DatapushPackageSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty DatapushPackageSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DatapushPackageSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DatapushPackageSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapushPackagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"xovis2\".\"datapush_package\".* FROM \"xovis2\".\"datapush_package\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, datapushPackagePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in DatapushPackageSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DatapushPackageExistsG checks if the DatapushPackage row exists.
+func DatapushPackageExistsG(ctx context.Context, serialNumber string, agentID int32, packageID int64, firstFrame int64) (bool, error) {
+	return DatapushPackageExists(ctx, boil.GetContextDB(), serialNumber, agentID, packageID, firstFrame)
+}
+
+// DatapushPackageExists checks if the DatapushPackage row exists.
+func DatapushPackageExists(ctx context.Context, exec boil.ContextExecutor, serialNumber string, agentID int32, packageID int64, firstFrame int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"xovis2\".\"datapush_package\" where \"serial_number\"=$1 AND \"agent_id\"=$2 AND \"package_id\"=$3 AND \"first_frame\"=$4 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, serialNumber, agentID, packageID, firstFrame)
+	}
+	row := exec.QueryRowContext(ctx, sql, serialNumber, agentID, packageID, firstFrame)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if datapush_package exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DatapushPackage row exists.
+func (o *DatapushPackage) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DatapushPackageExists(ctx, exec, o.SerialNumber, o.AgentID, o.PackageID, o.FirstFrame)
+}
@@ -0,0 +1,1107 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// DatapushQueue is an object representing the database table.
+type DatapushQueue struct {
+	ID                 int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID    int64       `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	Source             string      `boil:"source" json:"source" toml:"source" yaml:"source"`
+	SerialNumber       string      `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	Payload            types.JSON  `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Attempts           int32       `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	LastError          null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	ReceivedAt         time.Time   `boil:"received_at" json:"received_at" toml:"received_at" yaml:"received_at"`
+	NextAttemptAt      time.Time   `boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	LockedUntil        null.Time   `boil:"locked_until" json:"locked_until,omitempty" toml:"locked_until" yaml:"locked_until,omitempty"`
+	SideEffectsApplied bool        `boil:"side_effects_applied" json:"side_effects_applied" toml:"side_effects_applied" yaml:"side_effects_applied"`
+
+	R *datapushQueueR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L datapushQueueL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DatapushQueueColumns = struct {
+	ID                 string
+	ConfigurationID    string
+	Source             string
+	SerialNumber       string
+	Payload            string
+	Attempts           string
+	LastError          string
+	ReceivedAt         string
+	NextAttemptAt      string
+	LockedUntil        string
+	SideEffectsApplied string
+}{
+	ID:                 "id",
+	ConfigurationID:    "configuration_id",
+	Source:             "source",
+	SerialNumber:       "serial_number",
+	Payload:            "payload",
+	Attempts:           "attempts",
+	LastError:          "last_error",
+	ReceivedAt:         "received_at",
+	NextAttemptAt:      "next_attempt_at",
+	LockedUntil:        "locked_until",
+	SideEffectsApplied: "side_effects_applied",
+}
+
+var DatapushQueueTableColumns = struct {
+	ID                 string
+	ConfigurationID    string
+	Source             string
+	SerialNumber       string
+	Payload            string
+	Attempts           string
+	LastError          string
+	ReceivedAt         string
+	NextAttemptAt      string
+	LockedUntil        string
+	SideEffectsApplied string
+}{
+	ID:                 "datapush_queue.id",
+	ConfigurationID:    "datapush_queue.configuration_id",
+	Source:             "datapush_queue.source",
+	SerialNumber:       "datapush_queue.serial_number",
+	Payload:            "datapush_queue.payload",
+	Attempts:           "datapush_queue.attempts",
+	LastError:          "datapush_queue.last_error",
+	ReceivedAt:         "datapush_queue.received_at",
+	NextAttemptAt:      "datapush_queue.next_attempt_at",
+	LockedUntil:        "datapush_queue.locked_until",
+	SideEffectsApplied: "datapush_queue.side_effects_applied",
+}
+
+// Generated where
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var DatapushQueueWhere = struct {
+	ID                 whereHelperint64
+	ConfigurationID    whereHelperint64
+	Source             whereHelperstring
+	SerialNumber       whereHelperstring
+	Payload            whereHelpertypes_JSON
+	Attempts           whereHelperint32
+	LastError          whereHelpernull_String
+	ReceivedAt         whereHelpertime_Time
+	NextAttemptAt      whereHelpertime_Time
+	LockedUntil        whereHelpernull_Time
+	SideEffectsApplied whereHelperbool
+}{
+	ID:                 whereHelperint64{field: "\"xovis2\".\"datapush_queue\".\"id\""},
+	ConfigurationID:    whereHelperint64{field: "\"xovis2\".\"datapush_queue\".\"configuration_id\""},
+	Source:             whereHelperstring{field: "\"xovis2\".\"datapush_queue\".\"source\""},
+	SerialNumber:       whereHelperstring{field: "\"xovis2\".\"datapush_queue\".\"serial_number\""},
+	Payload:            whereHelpertypes_JSON{field: "\"xovis2\".\"datapush_queue\".\"payload\""},
+	Attempts:           whereHelperint32{field: "\"xovis2\".\"datapush_queue\".\"attempts\""},
+	LastError:          whereHelpernull_String{field: "\"xovis2\".\"datapush_queue\".\"last_error\""},
+	ReceivedAt:         whereHelpertime_Time{field: "\"xovis2\".\"datapush_queue\".\"received_at\""},
+	NextAttemptAt:      whereHelpertime_Time{field: "\"xovis2\".\"datapush_queue\".\"next_attempt_at\""},
+	LockedUntil:        whereHelpernull_Time{field: "\"xovis2\".\"datapush_queue\".\"locked_until\""},
+	SideEffectsApplied: whereHelperbool{field: "\"xovis2\".\"datapush_queue\".\"side_effects_applied\""},
+}
+
+// DatapushQueueRels is where relationship names are stored.
+var DatapushQueueRels = struct {
+}{}
+
+// datapushQueueR is where relationships are stored.
+type datapushQueueR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*datapushQueueR) NewStruct() *datapushQueueR {
+	return &datapushQueueR{}
+}
+
+// datapushQueueL is where Load methods for each relationship are stored.
+type datapushQueueL struct{}
+
+var (
+	datapushQueueAllColumns            = []string{"id", "configuration_id", "source", "serial_number", "payload", "attempts", "last_error", "received_at", "next_attempt_at", "locked_until", "side_effects_applied"}
+	datapushQueueColumnsWithoutDefault = []string{"configuration_id", "source", "serial_number", "payload"}
+	datapushQueueColumnsWithDefault    = []string{"id", "attempts", "last_error", "received_at", "next_attempt_at", "locked_until", "side_effects_applied"}
+	datapushQueuePrimaryKeyColumns     = []string{"id"}
+	datapushQueueGeneratedColumns      = []string{}
+)
+
+type (
+	// DatapushQueueSlice is an alias for a slice of pointers to DatapushQueue.
+	// This should almost always be used instead of []DatapushQueue.
+	DatapushQueueSlice []*DatapushQueue
+	// DatapushQueueHook is the signature for custom DatapushQueue hook methods
+	DatapushQueueHook func(context.Context, boil.ContextExecutor, *DatapushQueue) error
+
+	datapushQueueQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	datapushQueueType                 = reflect.TypeOf(&DatapushQueue{})
+	datapushQueueMapping              = queries.MakeStructMapping(datapushQueueType)
+	datapushQueuePrimaryKeyMapping, _ = queries.BindMapping(datapushQueueType, datapushQueueMapping, datapushQueuePrimaryKeyColumns)
+	datapushQueueInsertCacheMut       sync.RWMutex
+	datapushQueueInsertCache          = make(map[string]insertCache)
+	datapushQueueUpdateCacheMut       sync.RWMutex
+	datapushQueueUpdateCache          = make(map[string]updateCache)
+	datapushQueueUpsertCacheMut       sync.RWMutex
+	datapushQueueUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var datapushQueueAfterSelectMu sync.Mutex
+var datapushQueueAfterSelectHooks []DatapushQueueHook
+
+var datapushQueueBeforeInsertMu sync.Mutex
+var datapushQueueBeforeInsertHooks []DatapushQueueHook
+var datapushQueueAfterInsertMu sync.Mutex
+var datapushQueueAfterInsertHooks []DatapushQueueHook
+
+var datapushQueueBeforeUpdateMu sync.Mutex
+var datapushQueueBeforeUpdateHooks []DatapushQueueHook
+var datapushQueueAfterUpdateMu sync.Mutex
+var datapushQueueAfterUpdateHooks []DatapushQueueHook
+
+var datapushQueueBeforeDeleteMu sync.Mutex
+var datapushQueueBeforeDeleteHooks []DatapushQueueHook
+var datapushQueueAfterDeleteMu sync.Mutex
+var datapushQueueAfterDeleteHooks []DatapushQueueHook
+
+var datapushQueueBeforeUpsertMu sync.Mutex
+var datapushQueueBeforeUpsertHooks []DatapushQueueHook
+var datapushQueueAfterUpsertMu sync.Mutex
+var datapushQueueAfterUpsertHooks []DatapushQueueHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DatapushQueue) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushQueueAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DatapushQueue) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushQueueBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DatapushQueue) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushQueueAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DatapushQueue) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushQueueBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DatapushQueue) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushQueueAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DatapushQueue) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushQueueBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DatapushQueue) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushQueueAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DatapushQueue) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushQueueBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DatapushQueue) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapushQueueAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDatapushQueueHook registers your hook function for all future operations.
+func AddDatapushQueueHook(hookPoint boil.HookPoint, datapushQueueHook DatapushQueueHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		datapushQueueAfterSelectMu.Lock()
+		datapushQueueAfterSelectHooks = append(datapushQueueAfterSelectHooks, datapushQueueHook)
+		datapushQueueAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		datapushQueueBeforeInsertMu.Lock()
+		datapushQueueBeforeInsertHooks = append(datapushQueueBeforeInsertHooks, datapushQueueHook)
+		datapushQueueBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		datapushQueueAfterInsertMu.Lock()
+		datapushQueueAfterInsertHooks = append(datapushQueueAfterInsertHooks, datapushQueueHook)
+		datapushQueueAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		datapushQueueBeforeUpdateMu.Lock()
+		datapushQueueBeforeUpdateHooks = append(datapushQueueBeforeUpdateHooks, datapushQueueHook)
+		datapushQueueBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		datapushQueueAfterUpdateMu.Lock()
+		datapushQueueAfterUpdateHooks = append(datapushQueueAfterUpdateHooks, datapushQueueHook)
+		datapushQueueAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		datapushQueueBeforeDeleteMu.Lock()
+		datapushQueueBeforeDeleteHooks = append(datapushQueueBeforeDeleteHooks, datapushQueueHook)
+		datapushQueueBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		datapushQueueAfterDeleteMu.Lock()
+		datapushQueueAfterDeleteHooks = append(datapushQueueAfterDeleteHooks, datapushQueueHook)
+		datapushQueueAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		datapushQueueBeforeUpsertMu.Lock()
+		datapushQueueBeforeUpsertHooks = append(datapushQueueBeforeUpsertHooks, datapushQueueHook)
+		datapushQueueBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		datapushQueueAfterUpsertMu.Lock()
+		datapushQueueAfterUpsertHooks = append(datapushQueueAfterUpsertHooks, datapushQueueHook)
+		datapushQueueAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single datapushQueue record from the query using the global executor.
+func (q datapushQueueQuery) OneG(ctx context.Context) (*DatapushQueue, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single datapushQueue record from the query.
+func (q datapushQueueQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DatapushQueue, error) {
+	o := &DatapushQueue{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for datapush_queue")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all DatapushQueue records from the query using the global executor.
+func (q datapushQueueQuery) AllG(ctx context.Context) (DatapushQueueSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all DatapushQueue records from the query.
+func (q datapushQueueQuery) All(ctx context.Context, exec boil.ContextExecutor) (DatapushQueueSlice, error) {
+	var o []*DatapushQueue
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to DatapushQueue slice")
+	}
+
+	if len(datapushQueueAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all DatapushQueue records in the query using the global executor
+func (q datapushQueueQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all DatapushQueue records in the query.
+func (q datapushQueueQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count datapush_queue rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q datapushQueueQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q datapushQueueQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if datapush_queue exists")
+	}
+
+	return count > 0, nil
+}
+
+// DatapushQueues retrieves all the records using an executor.
+func DatapushQueues(mods ...qm.QueryMod) datapushQueueQuery {
+	mods = append(mods, qm.From("\"xovis2\".\"datapush_queue\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"xovis2\".\"datapush_queue\".*"})
+	}
+
+	return datapushQueueQuery{q}
+}
+
+// FindDatapushQueueG retrieves a single record by ID.
+func FindDatapushQueueG(ctx context.Context, iD int64, selectCols ...string) (*DatapushQueue, error) {
+	return FindDatapushQueue(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindDatapushQueue retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDatapushQueue(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*DatapushQueue, error) {
+	datapushQueueObj := &DatapushQueue{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"xovis2\".\"datapush_queue\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, datapushQueueObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from datapush_queue")
+	}
+
+	if err = datapushQueueObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return datapushQueueObj, err
+	}
+
+	return datapushQueueObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *DatapushQueue) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DatapushQueue) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no datapush_queue provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(datapushQueueColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	datapushQueueInsertCacheMut.RLock()
+	cache, cached := datapushQueueInsertCache[key]
+	datapushQueueInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			datapushQueueAllColumns,
+			datapushQueueColumnsWithDefault,
+			datapushQueueColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(datapushQueueType, datapushQueueMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(datapushQueueType, datapushQueueMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"xovis2\".\"datapush_queue\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"xovis2\".\"datapush_queue\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into datapush_queue")
+	}
+
+	if !cached {
+		datapushQueueInsertCacheMut.Lock()
+		datapushQueueInsertCache[key] = cache
+		datapushQueueInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single DatapushQueue record using the global executor.
+// See Update for more documentation.
+func (o *DatapushQueue) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the DatapushQueue.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DatapushQueue) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	datapushQueueUpdateCacheMut.RLock()
+	cache, cached := datapushQueueUpdateCache[key]
+	datapushQueueUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			datapushQueueAllColumns,
+			datapushQueuePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update datapush_queue, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"xovis2\".\"datapush_queue\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, datapushQueuePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(datapushQueueType, datapushQueueMapping, append(wl, datapushQueuePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update datapush_queue row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for datapush_queue")
+	}
+
+	if !cached {
+		datapushQueueUpdateCacheMut.Lock()
+		datapushQueueUpdateCache[key] = cache
+		datapushQueueUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q datapushQueueQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q datapushQueueQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for datapush_queue")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for datapush_queue")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o DatapushQueueSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DatapushQueueSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapushQueuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"xovis2\".\"datapush_queue\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, datapushQueuePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in datapushQueue slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all datapushQueue")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *DatapushQueue) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DatapushQueue) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no datapush_queue provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(datapushQueueColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	datapushQueueUpsertCacheMut.RLock()
+	cache, cached := datapushQueueUpsertCache[key]
+	datapushQueueUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			datapushQueueAllColumns,
+			datapushQueueColumnsWithDefault,
+			datapushQueueColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			datapushQueueAllColumns,
+			datapushQueuePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert datapush_queue, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(datapushQueueAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(datapushQueuePrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert datapush_queue, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(datapushQueuePrimaryKeyColumns))
+			copy(conflict, datapushQueuePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"xovis2\".\"datapush_queue\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(datapushQueueType, datapushQueueMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(datapushQueueType, datapushQueueMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert datapush_queue")
+	}
+
+	if !cached {
+		datapushQueueUpsertCacheMut.Lock()
+		datapushQueueUpsertCache[key] = cache
+		datapushQueueUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single DatapushQueue record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *DatapushQueue) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single DatapushQueue record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DatapushQueue) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no DatapushQueue provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), datapushQueuePrimaryKeyMapping)
+	sql := "DELETE FROM \"xovis2\".\"datapush_queue\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from datapush_queue")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for datapush_queue")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q datapushQueueQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q datapushQueueQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no datapushQueueQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from datapush_queue")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for datapush_queue")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o DatapushQueueSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DatapushQueueSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(datapushQueueBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapushQueuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"xovis2\".\"datapush_queue\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, datapushQueuePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from datapushQueue slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for datapush_queue")
+	}
+
+	if len(datapushQueueAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *DatapushQueue) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no DatapushQueue provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DatapushQueue) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDatapushQueue(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DatapushQueueSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty DatapushQueueSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DatapushQueueSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DatapushQueueSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapushQueuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"xovis2\".\"datapush_queue\".* FROM \"xovis2\".\"datapush_queue\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, datapushQueuePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in DatapushQueueSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DatapushQueueExistsG checks if the DatapushQueue row exists.
+func DatapushQueueExistsG(ctx context.Context, iD int64) (bool, error) {
+	return DatapushQueueExists(ctx, boil.GetContextDB(), iD)
+}
+
+// DatapushQueueExists checks if the DatapushQueue row exists.
+func DatapushQueueExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"xovis2\".\"datapush_queue\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if datapush_queue exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DatapushQueue row exists.
+func (o *DatapushQueue) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DatapushQueueExists(ctx, exec, o.ID)
+}
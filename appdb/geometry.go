@@ -0,0 +1,1096 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Geometry is an object representing the database table.
+type Geometry struct {
+	SerialNumber string    `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	LogicID      int32     `boil:"logic_id" json:"logic_id" toml:"logic_id" yaml:"logic_id"`
+	GeometryID   int32     `boil:"geometry_id" json:"geometry_id" toml:"geometry_id" yaml:"geometry_id"`
+	Name         string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Type         string    `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Points       null.JSON `boil:"points" json:"points,omitempty" toml:"points" yaml:"points,omitempty"`
+	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *geometryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L geometryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var GeometryColumns = struct {
+	SerialNumber string
+	LogicID      string
+	GeometryID   string
+	Name         string
+	Type         string
+	Points       string
+	UpdatedAt    string
+}{
+	SerialNumber: "serial_number",
+	LogicID:      "logic_id",
+	GeometryID:   "geometry_id",
+	Name:         "name",
+	Type:         "type",
+	Points:       "points",
+	UpdatedAt:    "updated_at",
+}
+
+var GeometryTableColumns = struct {
+	SerialNumber string
+	LogicID      string
+	GeometryID   string
+	Name         string
+	Type         string
+	Points       string
+	UpdatedAt    string
+}{
+	SerialNumber: "geometry.serial_number",
+	LogicID:      "geometry.logic_id",
+	GeometryID:   "geometry.geometry_id",
+	Name:         "geometry.name",
+	Type:         "geometry.type",
+	Points:       "geometry.points",
+	UpdatedAt:    "geometry.updated_at",
+}
+
+// Generated where
+
+type whereHelpernull_JSON struct{ field string }
+
+func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_JSON) NEQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_JSON) LT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_JSON) LTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_JSON) GT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var GeometryWhere = struct {
+	SerialNumber whereHelperstring
+	LogicID      whereHelperint32
+	GeometryID   whereHelperint32
+	Name         whereHelperstring
+	Type         whereHelperstring
+	Points       whereHelpernull_JSON
+	UpdatedAt    whereHelpertime_Time
+}{
+	SerialNumber: whereHelperstring{field: "\"xovis2\".\"geometry\".\"serial_number\""},
+	LogicID:      whereHelperint32{field: "\"xovis2\".\"geometry\".\"logic_id\""},
+	GeometryID:   whereHelperint32{field: "\"xovis2\".\"geometry\".\"geometry_id\""},
+	Name:         whereHelperstring{field: "\"xovis2\".\"geometry\".\"name\""},
+	Type:         whereHelperstring{field: "\"xovis2\".\"geometry\".\"type\""},
+	Points:       whereHelpernull_JSON{field: "\"xovis2\".\"geometry\".\"points\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"xovis2\".\"geometry\".\"updated_at\""},
+}
+
+// GeometryRels is where relationship names are stored.
+var GeometryRels = struct {
+}{}
+
+// geometryR is where relationships are stored.
+type geometryR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*geometryR) NewStruct() *geometryR {
+	return &geometryR{}
+}
+
+// geometryL is where Load methods for each relationship are stored.
+type geometryL struct{}
+
+var (
+	geometryAllColumns            = []string{"serial_number", "logic_id", "geometry_id", "name", "type", "points", "updated_at"}
+	geometryColumnsWithoutDefault = []string{"serial_number", "logic_id", "geometry_id", "name", "type"}
+	geometryColumnsWithDefault    = []string{"points", "updated_at"}
+	geometryPrimaryKeyColumns     = []string{"serial_number", "logic_id", "geometry_id"}
+	geometryGeneratedColumns      = []string{}
+)
+
+type (
+	// GeometrySlice is an alias for a slice of pointers to Geometry.
+	// This should almost always be used instead of []Geometry.
+	GeometrySlice []*Geometry
+	// GeometryHook is the signature for custom Geometry hook methods
+	GeometryHook func(context.Context, boil.ContextExecutor, *Geometry) error
+
+	geometryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	geometryType                 = reflect.TypeOf(&Geometry{})
+	geometryMapping              = queries.MakeStructMapping(geometryType)
+	geometryPrimaryKeyMapping, _ = queries.BindMapping(geometryType, geometryMapping, geometryPrimaryKeyColumns)
+	geometryInsertCacheMut       sync.RWMutex
+	geometryInsertCache          = make(map[string]insertCache)
+	geometryUpdateCacheMut       sync.RWMutex
+	geometryUpdateCache          = make(map[string]updateCache)
+	geometryUpsertCacheMut       sync.RWMutex
+	geometryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var geometryAfterSelectMu sync.Mutex
+var geometryAfterSelectHooks []GeometryHook
+
+var geometryBeforeInsertMu sync.Mutex
+var geometryBeforeInsertHooks []GeometryHook
+var geometryAfterInsertMu sync.Mutex
+var geometryAfterInsertHooks []GeometryHook
+
+var geometryBeforeUpdateMu sync.Mutex
+var geometryBeforeUpdateHooks []GeometryHook
+var geometryAfterUpdateMu sync.Mutex
+var geometryAfterUpdateHooks []GeometryHook
+
+var geometryBeforeDeleteMu sync.Mutex
+var geometryBeforeDeleteHooks []GeometryHook
+var geometryAfterDeleteMu sync.Mutex
+var geometryAfterDeleteHooks []GeometryHook
+
+var geometryBeforeUpsertMu sync.Mutex
+var geometryBeforeUpsertHooks []GeometryHook
+var geometryAfterUpsertMu sync.Mutex
+var geometryAfterUpsertHooks []GeometryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Geometry) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range geometryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Geometry) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range geometryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Geometry) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range geometryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Geometry) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range geometryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Geometry) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range geometryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Geometry) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range geometryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Geometry) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range geometryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Geometry) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range geometryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Geometry) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range geometryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddGeometryHook registers your hook function for all future operations.
+func AddGeometryHook(hookPoint boil.HookPoint, geometryHook GeometryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		geometryAfterSelectMu.Lock()
+		geometryAfterSelectHooks = append(geometryAfterSelectHooks, geometryHook)
+		geometryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		geometryBeforeInsertMu.Lock()
+		geometryBeforeInsertHooks = append(geometryBeforeInsertHooks, geometryHook)
+		geometryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		geometryAfterInsertMu.Lock()
+		geometryAfterInsertHooks = append(geometryAfterInsertHooks, geometryHook)
+		geometryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		geometryBeforeUpdateMu.Lock()
+		geometryBeforeUpdateHooks = append(geometryBeforeUpdateHooks, geometryHook)
+		geometryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		geometryAfterUpdateMu.Lock()
+		geometryAfterUpdateHooks = append(geometryAfterUpdateHooks, geometryHook)
+		geometryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		geometryBeforeDeleteMu.Lock()
+		geometryBeforeDeleteHooks = append(geometryBeforeDeleteHooks, geometryHook)
+		geometryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		geometryAfterDeleteMu.Lock()
+		geometryAfterDeleteHooks = append(geometryAfterDeleteHooks, geometryHook)
+		geometryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		geometryBeforeUpsertMu.Lock()
+		geometryBeforeUpsertHooks = append(geometryBeforeUpsertHooks, geometryHook)
+		geometryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		geometryAfterUpsertMu.Lock()
+		geometryAfterUpsertHooks = append(geometryAfterUpsertHooks, geometryHook)
+		geometryAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single geometry record from the query using the global executor.
+func (q geometryQuery) OneG(ctx context.Context) (*Geometry, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single geometry record from the query.
+func (q geometryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Geometry, error) {
+	o := &Geometry{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for geometry")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Geometry records from the query using the global executor.
+func (q geometryQuery) AllG(ctx context.Context) (GeometrySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Geometry records from the query.
+func (q geometryQuery) All(ctx context.Context, exec boil.ContextExecutor) (GeometrySlice, error) {
+	var o []*Geometry
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to Geometry slice")
+	}
+
+	if len(geometryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Geometry records in the query using the global executor
+func (q geometryQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Geometry records in the query.
+func (q geometryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count geometry rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q geometryQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q geometryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if geometry exists")
+	}
+
+	return count > 0, nil
+}
+
+// Geometries retrieves all the records using an executor.
+func Geometries(mods ...qm.QueryMod) geometryQuery {
+	mods = append(mods, qm.From("\"xovis2\".\"geometry\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"xovis2\".\"geometry\".*"})
+	}
+
+	return geometryQuery{q}
+}
+
+// FindGeometryG retrieves a single record by ID.
+func FindGeometryG(ctx context.Context, serialNumber string, logicID int32, geometryID int32, selectCols ...string) (*Geometry, error) {
+	return FindGeometry(ctx, boil.GetContextDB(), serialNumber, logicID, geometryID, selectCols...)
+}
+
+// FindGeometry retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindGeometry(ctx context.Context, exec boil.ContextExecutor, serialNumber string, logicID int32, geometryID int32, selectCols ...string) (*Geometry, error) {
+	geometryObj := &Geometry{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"xovis2\".\"geometry\" where \"serial_number\"=$1 AND \"logic_id\"=$2 AND \"geometry_id\"=$3", sel,
+	)
+
+	q := queries.Raw(query, serialNumber, logicID, geometryID)
+
+	err := q.Bind(ctx, exec, geometryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from geometry")
+	}
+
+	if err = geometryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return geometryObj, err
+	}
+
+	return geometryObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Geometry) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Geometry) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no geometry provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(geometryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	geometryInsertCacheMut.RLock()
+	cache, cached := geometryInsertCache[key]
+	geometryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			geometryAllColumns,
+			geometryColumnsWithDefault,
+			geometryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(geometryType, geometryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(geometryType, geometryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"xovis2\".\"geometry\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"xovis2\".\"geometry\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into geometry")
+	}
+
+	if !cached {
+		geometryInsertCacheMut.Lock()
+		geometryInsertCache[key] = cache
+		geometryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single Geometry record using the global executor.
+// See Update for more documentation.
+func (o *Geometry) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Geometry.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Geometry) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	geometryUpdateCacheMut.RLock()
+	cache, cached := geometryUpdateCache[key]
+	geometryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			geometryAllColumns,
+			geometryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update geometry, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"xovis2\".\"geometry\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, geometryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(geometryType, geometryMapping, append(wl, geometryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update geometry row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for geometry")
+	}
+
+	if !cached {
+		geometryUpdateCacheMut.Lock()
+		geometryUpdateCache[key] = cache
+		geometryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q geometryQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q geometryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for geometry")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for geometry")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o GeometrySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o GeometrySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), geometryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"xovis2\".\"geometry\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, geometryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in geometry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all geometry")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Geometry) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Geometry) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no geometry provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(geometryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	geometryUpsertCacheMut.RLock()
+	cache, cached := geometryUpsertCache[key]
+	geometryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			geometryAllColumns,
+			geometryColumnsWithDefault,
+			geometryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			geometryAllColumns,
+			geometryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert geometry, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(geometryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(geometryPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert geometry, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(geometryPrimaryKeyColumns))
+			copy(conflict, geometryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"xovis2\".\"geometry\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(geometryType, geometryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(geometryType, geometryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert geometry")
+	}
+
+	if !cached {
+		geometryUpsertCacheMut.Lock()
+		geometryUpsertCache[key] = cache
+		geometryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single Geometry record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Geometry) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Geometry record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Geometry) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no Geometry provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), geometryPrimaryKeyMapping)
+	sql := "DELETE FROM \"xovis2\".\"geometry\" WHERE \"serial_number\"=$1 AND \"logic_id\"=$2 AND \"geometry_id\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from geometry")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for geometry")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q geometryQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q geometryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no geometryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from geometry")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for geometry")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o GeometrySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o GeometrySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(geometryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), geometryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"xovis2\".\"geometry\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, geometryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from geometry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for geometry")
+	}
+
+	if len(geometryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Geometry) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no Geometry provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Geometry) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindGeometry(ctx, exec, o.SerialNumber, o.LogicID, o.GeometryID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GeometrySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty GeometrySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GeometrySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := GeometrySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), geometryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"xovis2\".\"geometry\".* FROM \"xovis2\".\"geometry\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, geometryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in GeometrySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// GeometryExistsG checks if the Geometry row exists.
+func GeometryExistsG(ctx context.Context, serialNumber string, logicID int32, geometryID int32) (bool, error) {
+	return GeometryExists(ctx, boil.GetContextDB(), serialNumber, logicID, geometryID)
+}
+
+// GeometryExists checks if the Geometry row exists.
+func GeometryExists(ctx context.Context, exec boil.ContextExecutor, serialNumber string, logicID int32, geometryID int32) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"xovis2\".\"geometry\" where \"serial_number\"=$1 AND \"logic_id\"=$2 AND \"geometry_id\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, serialNumber, logicID, geometryID)
+	}
+	row := exec.QueryRowContext(ctx, sql, serialNumber, logicID, geometryID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if geometry exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Geometry row exists.
+func (o *Geometry) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return GeometryExists(ctx, exec, o.SerialNumber, o.LogicID, o.GeometryID)
+}
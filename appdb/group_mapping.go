@@ -0,0 +1,1243 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// GroupMapping is an object representing the database table.
+type GroupMapping struct {
+	ID              int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID int64             `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	GroupName       string            `boil:"group_name" json:"group_name" toml:"group_name" yaml:"group_name"`
+	ProjectIds      types.StringArray `boil:"project_ids" json:"project_ids,omitempty" toml:"project_ids" yaml:"project_ids,omitempty"`
+	ParentAssetID   null.Int32        `boil:"parent_asset_id" json:"parent_asset_id,omitempty" toml:"parent_asset_id" yaml:"parent_asset_id,omitempty"`
+
+	R *groupMappingR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L groupMappingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var GroupMappingColumns = struct {
+	ID              string
+	ConfigurationID string
+	GroupName       string
+	ProjectIds      string
+	ParentAssetID   string
+}{
+	ID:              "id",
+	ConfigurationID: "configuration_id",
+	GroupName:       "group_name",
+	ProjectIds:      "project_ids",
+	ParentAssetID:   "parent_asset_id",
+}
+
+var GroupMappingTableColumns = struct {
+	ID              string
+	ConfigurationID string
+	GroupName       string
+	ProjectIds      string
+	ParentAssetID   string
+}{
+	ID:              "group_mapping.id",
+	ConfigurationID: "group_mapping.configuration_id",
+	GroupName:       "group_mapping.group_name",
+	ProjectIds:      "group_mapping.project_ids",
+	ParentAssetID:   "group_mapping.parent_asset_id",
+}
+
+// Generated where
+
+func (w whereHelpertypes_StringArray) IsNull() qm.QueryMod { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpertypes_StringArray) IsNotNull() qm.QueryMod {
+	return qmhelper.WhereIsNotNull(w.field)
+}
+
+var GroupMappingWhere = struct {
+	ID              whereHelperint64
+	ConfigurationID whereHelperint64
+	GroupName       whereHelperstring
+	ProjectIds      whereHelpertypes_StringArray
+	ParentAssetID   whereHelpernull_Int32
+}{
+	ID:              whereHelperint64{field: "\"xovis2\".\"group_mapping\".\"id\""},
+	ConfigurationID: whereHelperint64{field: "\"xovis2\".\"group_mapping\".\"configuration_id\""},
+	GroupName:       whereHelperstring{field: "\"xovis2\".\"group_mapping\".\"group_name\""},
+	ProjectIds:      whereHelpertypes_StringArray{field: "\"xovis2\".\"group_mapping\".\"project_ids\""},
+	ParentAssetID:   whereHelpernull_Int32{field: "\"xovis2\".\"group_mapping\".\"parent_asset_id\""},
+}
+
+// GroupMappingRels is where relationship names are stored.
+var GroupMappingRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// groupMappingR is where relationships are stored.
+type groupMappingR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*groupMappingR) NewStruct() *groupMappingR {
+	return &groupMappingR{}
+}
+
+func (r *groupMappingR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// groupMappingL is where Load methods for each relationship are stored.
+type groupMappingL struct{}
+
+var (
+	groupMappingAllColumns            = []string{"id", "configuration_id", "group_name", "project_ids", "parent_asset_id"}
+	groupMappingColumnsWithoutDefault = []string{"group_name"}
+	groupMappingColumnsWithDefault    = []string{"id", "configuration_id", "project_ids", "parent_asset_id"}
+	groupMappingPrimaryKeyColumns     = []string{"id"}
+	groupMappingGeneratedColumns      = []string{}
+)
+
+type (
+	// GroupMappingSlice is an alias for a slice of pointers to GroupMapping.
+	// This should almost always be used instead of []GroupMapping.
+	GroupMappingSlice []*GroupMapping
+	// GroupMappingHook is the signature for custom GroupMapping hook methods
+	GroupMappingHook func(context.Context, boil.ContextExecutor, *GroupMapping) error
+
+	groupMappingQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	groupMappingType                 = reflect.TypeOf(&GroupMapping{})
+	groupMappingMapping              = queries.MakeStructMapping(groupMappingType)
+	groupMappingPrimaryKeyMapping, _ = queries.BindMapping(groupMappingType, groupMappingMapping, groupMappingPrimaryKeyColumns)
+	groupMappingInsertCacheMut       sync.RWMutex
+	groupMappingInsertCache          = make(map[string]insertCache)
+	groupMappingUpdateCacheMut       sync.RWMutex
+	groupMappingUpdateCache          = make(map[string]updateCache)
+	groupMappingUpsertCacheMut       sync.RWMutex
+	groupMappingUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var groupMappingAfterSelectMu sync.Mutex
+var groupMappingAfterSelectHooks []GroupMappingHook
+
+var groupMappingBeforeInsertMu sync.Mutex
+var groupMappingBeforeInsertHooks []GroupMappingHook
+var groupMappingAfterInsertMu sync.Mutex
+var groupMappingAfterInsertHooks []GroupMappingHook
+
+var groupMappingBeforeUpdateMu sync.Mutex
+var groupMappingBeforeUpdateHooks []GroupMappingHook
+var groupMappingAfterUpdateMu sync.Mutex
+var groupMappingAfterUpdateHooks []GroupMappingHook
+
+var groupMappingBeforeDeleteMu sync.Mutex
+var groupMappingBeforeDeleteHooks []GroupMappingHook
+var groupMappingAfterDeleteMu sync.Mutex
+var groupMappingAfterDeleteHooks []GroupMappingHook
+
+var groupMappingBeforeUpsertMu sync.Mutex
+var groupMappingBeforeUpsertHooks []GroupMappingHook
+var groupMappingAfterUpsertMu sync.Mutex
+var groupMappingAfterUpsertHooks []GroupMappingHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *GroupMapping) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMappingAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *GroupMapping) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMappingBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *GroupMapping) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMappingAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *GroupMapping) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMappingBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *GroupMapping) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMappingAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *GroupMapping) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMappingBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *GroupMapping) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMappingAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *GroupMapping) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMappingBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *GroupMapping) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMappingAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddGroupMappingHook registers your hook function for all future operations.
+func AddGroupMappingHook(hookPoint boil.HookPoint, groupMappingHook GroupMappingHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		groupMappingAfterSelectMu.Lock()
+		groupMappingAfterSelectHooks = append(groupMappingAfterSelectHooks, groupMappingHook)
+		groupMappingAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		groupMappingBeforeInsertMu.Lock()
+		groupMappingBeforeInsertHooks = append(groupMappingBeforeInsertHooks, groupMappingHook)
+		groupMappingBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		groupMappingAfterInsertMu.Lock()
+		groupMappingAfterInsertHooks = append(groupMappingAfterInsertHooks, groupMappingHook)
+		groupMappingAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		groupMappingBeforeUpdateMu.Lock()
+		groupMappingBeforeUpdateHooks = append(groupMappingBeforeUpdateHooks, groupMappingHook)
+		groupMappingBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		groupMappingAfterUpdateMu.Lock()
+		groupMappingAfterUpdateHooks = append(groupMappingAfterUpdateHooks, groupMappingHook)
+		groupMappingAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		groupMappingBeforeDeleteMu.Lock()
+		groupMappingBeforeDeleteHooks = append(groupMappingBeforeDeleteHooks, groupMappingHook)
+		groupMappingBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		groupMappingAfterDeleteMu.Lock()
+		groupMappingAfterDeleteHooks = append(groupMappingAfterDeleteHooks, groupMappingHook)
+		groupMappingAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		groupMappingBeforeUpsertMu.Lock()
+		groupMappingBeforeUpsertHooks = append(groupMappingBeforeUpsertHooks, groupMappingHook)
+		groupMappingBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		groupMappingAfterUpsertMu.Lock()
+		groupMappingAfterUpsertHooks = append(groupMappingAfterUpsertHooks, groupMappingHook)
+		groupMappingAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single groupMapping record from the query using the global executor.
+func (q groupMappingQuery) OneG(ctx context.Context) (*GroupMapping, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single groupMapping record from the query.
+func (q groupMappingQuery) One(ctx context.Context, exec boil.ContextExecutor) (*GroupMapping, error) {
+	o := &GroupMapping{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for group_mapping")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all GroupMapping records from the query using the global executor.
+func (q groupMappingQuery) AllG(ctx context.Context) (GroupMappingSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all GroupMapping records from the query.
+func (q groupMappingQuery) All(ctx context.Context, exec boil.ContextExecutor) (GroupMappingSlice, error) {
+	var o []*GroupMapping
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to GroupMapping slice")
+	}
+
+	if len(groupMappingAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all GroupMapping records in the query using the global executor
+func (q groupMappingQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all GroupMapping records in the query.
+func (q groupMappingQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count group_mapping rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q groupMappingQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q groupMappingQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if group_mapping exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *GroupMapping) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (groupMappingL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroupMapping interface{}, mods queries.Applicator) error {
+	var slice []*GroupMapping
+	var object *GroupMapping
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroupMapping.(*GroupMapping)
+		if !ok {
+			object = new(GroupMapping)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroupMapping)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroupMapping))
+			}
+		}
+	} else {
+		s, ok := maybeGroupMapping.(*[]*GroupMapping)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroupMapping)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroupMapping))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupMappingR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupMappingR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`xovis2.configuration`),
+		qm.WhereIn(`xovis2.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.GroupMappings = append(foreign.R.GroupMappings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.GroupMappings = append(foreign.R.GroupMappings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the groupMapping to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.GroupMappings.
+// Uses the global database handle.
+func (o *GroupMapping) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the groupMapping to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.GroupMappings.
+func (o *GroupMapping) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"xovis2\".\"group_mapping\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, groupMappingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &groupMappingR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			GroupMappings: GroupMappingSlice{o},
+		}
+	} else {
+		related.R.GroupMappings = append(related.R.GroupMappings, o)
+	}
+
+	return nil
+}
+
+// GroupMappings retrieves all the records using an executor.
+func GroupMappings(mods ...qm.QueryMod) groupMappingQuery {
+	mods = append(mods, qm.From("\"xovis2\".\"group_mapping\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"xovis2\".\"group_mapping\".*"})
+	}
+
+	return groupMappingQuery{q}
+}
+
+// FindGroupMappingG retrieves a single record by ID.
+func FindGroupMappingG(ctx context.Context, iD int64, selectCols ...string) (*GroupMapping, error) {
+	return FindGroupMapping(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindGroupMapping retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindGroupMapping(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*GroupMapping, error) {
+	groupMappingObj := &GroupMapping{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"xovis2\".\"group_mapping\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, groupMappingObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from group_mapping")
+	}
+
+	if err = groupMappingObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return groupMappingObj, err
+	}
+
+	return groupMappingObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *GroupMapping) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *GroupMapping) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no group_mapping provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(groupMappingColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	groupMappingInsertCacheMut.RLock()
+	cache, cached := groupMappingInsertCache[key]
+	groupMappingInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			groupMappingAllColumns,
+			groupMappingColumnsWithDefault,
+			groupMappingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(groupMappingType, groupMappingMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(groupMappingType, groupMappingMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"xovis2\".\"group_mapping\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"xovis2\".\"group_mapping\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into group_mapping")
+	}
+
+	if !cached {
+		groupMappingInsertCacheMut.Lock()
+		groupMappingInsertCache[key] = cache
+		groupMappingInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single GroupMapping record using the global executor.
+// See Update for more documentation.
+func (o *GroupMapping) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the GroupMapping.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *GroupMapping) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	groupMappingUpdateCacheMut.RLock()
+	cache, cached := groupMappingUpdateCache[key]
+	groupMappingUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			groupMappingAllColumns,
+			groupMappingPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update group_mapping, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"xovis2\".\"group_mapping\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, groupMappingPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(groupMappingType, groupMappingMapping, append(wl, groupMappingPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update group_mapping row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for group_mapping")
+	}
+
+	if !cached {
+		groupMappingUpdateCacheMut.Lock()
+		groupMappingUpdateCache[key] = cache
+		groupMappingUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q groupMappingQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q groupMappingQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for group_mapping")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for group_mapping")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o GroupMappingSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o GroupMappingSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), groupMappingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"xovis2\".\"group_mapping\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, groupMappingPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in groupMapping slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all groupMapping")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *GroupMapping) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *GroupMapping) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no group_mapping provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(groupMappingColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	groupMappingUpsertCacheMut.RLock()
+	cache, cached := groupMappingUpsertCache[key]
+	groupMappingUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			groupMappingAllColumns,
+			groupMappingColumnsWithDefault,
+			groupMappingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			groupMappingAllColumns,
+			groupMappingPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert group_mapping, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(groupMappingAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(groupMappingPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert group_mapping, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(groupMappingPrimaryKeyColumns))
+			copy(conflict, groupMappingPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"xovis2\".\"group_mapping\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(groupMappingType, groupMappingMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(groupMappingType, groupMappingMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert group_mapping")
+	}
+
+	if !cached {
+		groupMappingUpsertCacheMut.Lock()
+		groupMappingUpsertCache[key] = cache
+		groupMappingUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single GroupMapping record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *GroupMapping) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single GroupMapping record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *GroupMapping) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no GroupMapping provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), groupMappingPrimaryKeyMapping)
+	sql := "DELETE FROM \"xovis2\".\"group_mapping\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from group_mapping")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for group_mapping")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q groupMappingQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q groupMappingQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no groupMappingQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from group_mapping")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for group_mapping")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o GroupMappingSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o GroupMappingSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(groupMappingBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), groupMappingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"xovis2\".\"group_mapping\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, groupMappingPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from groupMapping slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for group_mapping")
+	}
+
+	if len(groupMappingAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *GroupMapping) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no GroupMapping provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *GroupMapping) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindGroupMapping(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GroupMappingSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty GroupMappingSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GroupMappingSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := GroupMappingSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), groupMappingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"xovis2\".\"group_mapping\".* FROM \"xovis2\".\"group_mapping\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, groupMappingPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in GroupMappingSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// GroupMappingExistsG checks if the GroupMapping row exists.
+func GroupMappingExistsG(ctx context.Context, iD int64) (bool, error) {
+	return GroupMappingExists(ctx, boil.GetContextDB(), iD)
+}
+
+// GroupMappingExists checks if the GroupMapping row exists.
+func GroupMappingExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"xovis2\".\"group_mapping\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if group_mapping exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the GroupMapping row exists.
+func (o *GroupMapping) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return GroupMappingExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1117 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// Heatmap is an object representing the database table.
+type Heatmap struct {
+	ID           int64            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SerialNumber string           `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	GeometryID   int32            `boil:"geometry_id" json:"geometry_id" toml:"geometry_id" yaml:"geometry_id"`
+	GeometryName string           `boil:"geometry_name" json:"geometry_name" toml:"geometry_name" yaml:"geometry_name"`
+	WindowStart  time.Time        `boil:"window_start" json:"window_start" toml:"window_start" yaml:"window_start"`
+	WindowEnd    time.Time        `boil:"window_end" json:"window_end" toml:"window_end" yaml:"window_end"`
+	OriginX      float64          `boil:"origin_x" json:"origin_x" toml:"origin_x" yaml:"origin_x"`
+	OriginY      float64          `boil:"origin_y" json:"origin_y" toml:"origin_y" yaml:"origin_y"`
+	CellSize     float64          `boil:"cell_size" json:"cell_size" toml:"cell_size" yaml:"cell_size"`
+	GridColumns  int32            `boil:"grid_columns" json:"grid_columns" toml:"grid_columns" yaml:"grid_columns"`
+	GridRows     int32            `boil:"grid_rows" json:"grid_rows" toml:"grid_rows" yaml:"grid_rows"`
+	Cells        types.Int64Array `boil:"cells" json:"cells" toml:"cells" yaml:"cells"`
+	Frames       int32            `boil:"frames" json:"frames" toml:"frames" yaml:"frames"`
+
+	R *heatmapR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L heatmapL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var HeatmapColumns = struct {
+	ID           string
+	SerialNumber string
+	GeometryID   string
+	GeometryName string
+	WindowStart  string
+	WindowEnd    string
+	OriginX      string
+	OriginY      string
+	CellSize     string
+	GridColumns  string
+	GridRows     string
+	Cells        string
+	Frames       string
+}{
+	ID:           "id",
+	SerialNumber: "serial_number",
+	GeometryID:   "geometry_id",
+	GeometryName: "geometry_name",
+	WindowStart:  "window_start",
+	WindowEnd:    "window_end",
+	OriginX:      "origin_x",
+	OriginY:      "origin_y",
+	CellSize:     "cell_size",
+	GridColumns:  "grid_columns",
+	GridRows:     "grid_rows",
+	Cells:        "cells",
+	Frames:       "frames",
+}
+
+var HeatmapTableColumns = struct {
+	ID           string
+	SerialNumber string
+	GeometryID   string
+	GeometryName string
+	WindowStart  string
+	WindowEnd    string
+	OriginX      string
+	OriginY      string
+	CellSize     string
+	GridColumns  string
+	GridRows     string
+	Cells        string
+	Frames       string
+}{
+	ID:           "heatmap.id",
+	SerialNumber: "heatmap.serial_number",
+	GeometryID:   "heatmap.geometry_id",
+	GeometryName: "heatmap.geometry_name",
+	WindowStart:  "heatmap.window_start",
+	WindowEnd:    "heatmap.window_end",
+	OriginX:      "heatmap.origin_x",
+	OriginY:      "heatmap.origin_y",
+	CellSize:     "heatmap.cell_size",
+	GridColumns:  "heatmap.grid_columns",
+	GridRows:     "heatmap.grid_rows",
+	Cells:        "heatmap.cells",
+	Frames:       "heatmap.frames",
+}
+
+// Generated where
+
+type whereHelpertypes_Int64Array struct{ field string }
+
+func (w whereHelpertypes_Int64Array) EQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_Int64Array) NEQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_Int64Array) LT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_Int64Array) LTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_Int64Array) GT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var HeatmapWhere = struct {
+	ID           whereHelperint64
+	SerialNumber whereHelperstring
+	GeometryID   whereHelperint32
+	GeometryName whereHelperstring
+	WindowStart  whereHelpertime_Time
+	WindowEnd    whereHelpertime_Time
+	OriginX      whereHelperfloat64
+	OriginY      whereHelperfloat64
+	CellSize     whereHelperfloat64
+	GridColumns  whereHelperint32
+	GridRows     whereHelperint32
+	Cells        whereHelpertypes_Int64Array
+	Frames       whereHelperint32
+}{
+	ID:           whereHelperint64{field: "\"xovis2\".\"heatmap\".\"id\""},
+	SerialNumber: whereHelperstring{field: "\"xovis2\".\"heatmap\".\"serial_number\""},
+	GeometryID:   whereHelperint32{field: "\"xovis2\".\"heatmap\".\"geometry_id\""},
+	GeometryName: whereHelperstring{field: "\"xovis2\".\"heatmap\".\"geometry_name\""},
+	WindowStart:  whereHelpertime_Time{field: "\"xovis2\".\"heatmap\".\"window_start\""},
+	WindowEnd:    whereHelpertime_Time{field: "\"xovis2\".\"heatmap\".\"window_end\""},
+	OriginX:      whereHelperfloat64{field: "\"xovis2\".\"heatmap\".\"origin_x\""},
+	OriginY:      whereHelperfloat64{field: "\"xovis2\".\"heatmap\".\"origin_y\""},
+	CellSize:     whereHelperfloat64{field: "\"xovis2\".\"heatmap\".\"cell_size\""},
+	GridColumns:  whereHelperint32{field: "\"xovis2\".\"heatmap\".\"grid_columns\""},
+	GridRows:     whereHelperint32{field: "\"xovis2\".\"heatmap\".\"grid_rows\""},
+	Cells:        whereHelpertypes_Int64Array{field: "\"xovis2\".\"heatmap\".\"cells\""},
+	Frames:       whereHelperint32{field: "\"xovis2\".\"heatmap\".\"frames\""},
+}
+
+// HeatmapRels is where relationship names are stored.
+var HeatmapRels = struct {
+}{}
+
+// heatmapR is where relationships are stored.
+type heatmapR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*heatmapR) NewStruct() *heatmapR {
+	return &heatmapR{}
+}
+
+// heatmapL is where Load methods for each relationship are stored.
+type heatmapL struct{}
+
+var (
+	heatmapAllColumns            = []string{"id", "serial_number", "geometry_id", "geometry_name", "window_start", "window_end", "origin_x", "origin_y", "cell_size", "grid_columns", "grid_rows", "cells", "frames"}
+	heatmapColumnsWithoutDefault = []string{"serial_number", "geometry_id", "geometry_name", "window_start", "window_end", "origin_x", "origin_y", "cell_size", "grid_columns", "grid_rows", "cells", "frames"}
+	heatmapColumnsWithDefault    = []string{"id"}
+	heatmapPrimaryKeyColumns     = []string{"id"}
+	heatmapGeneratedColumns      = []string{}
+)
+
+type (
+	// HeatmapSlice is an alias for a slice of pointers to Heatmap.
+	// This should almost always be used instead of []Heatmap.
+	HeatmapSlice []*Heatmap
+	// HeatmapHook is the signature for custom Heatmap hook methods
+	HeatmapHook func(context.Context, boil.ContextExecutor, *Heatmap) error
+
+	heatmapQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	heatmapType                 = reflect.TypeOf(&Heatmap{})
+	heatmapMapping              = queries.MakeStructMapping(heatmapType)
+	heatmapPrimaryKeyMapping, _ = queries.BindMapping(heatmapType, heatmapMapping, heatmapPrimaryKeyColumns)
+	heatmapInsertCacheMut       sync.RWMutex
+	heatmapInsertCache          = make(map[string]insertCache)
+	heatmapUpdateCacheMut       sync.RWMutex
+	heatmapUpdateCache          = make(map[string]updateCache)
+	heatmapUpsertCacheMut       sync.RWMutex
+	heatmapUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var heatmapAfterSelectMu sync.Mutex
+var heatmapAfterSelectHooks []HeatmapHook
+
+var heatmapBeforeInsertMu sync.Mutex
+var heatmapBeforeInsertHooks []HeatmapHook
+var heatmapAfterInsertMu sync.Mutex
+var heatmapAfterInsertHooks []HeatmapHook
+
+var heatmapBeforeUpdateMu sync.Mutex
+var heatmapBeforeUpdateHooks []HeatmapHook
+var heatmapAfterUpdateMu sync.Mutex
+var heatmapAfterUpdateHooks []HeatmapHook
+
+var heatmapBeforeDeleteMu sync.Mutex
+var heatmapBeforeDeleteHooks []HeatmapHook
+var heatmapAfterDeleteMu sync.Mutex
+var heatmapAfterDeleteHooks []HeatmapHook
+
+var heatmapBeforeUpsertMu sync.Mutex
+var heatmapBeforeUpsertHooks []HeatmapHook
+var heatmapAfterUpsertMu sync.Mutex
+var heatmapAfterUpsertHooks []HeatmapHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Heatmap) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range heatmapAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Heatmap) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range heatmapBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Heatmap) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range heatmapAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Heatmap) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range heatmapBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Heatmap) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range heatmapAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Heatmap) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range heatmapBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Heatmap) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range heatmapAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Heatmap) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range heatmapBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Heatmap) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range heatmapAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddHeatmapHook registers your hook function for all future operations.
+func AddHeatmapHook(hookPoint boil.HookPoint, heatmapHook HeatmapHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		heatmapAfterSelectMu.Lock()
+		heatmapAfterSelectHooks = append(heatmapAfterSelectHooks, heatmapHook)
+		heatmapAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		heatmapBeforeInsertMu.Lock()
+		heatmapBeforeInsertHooks = append(heatmapBeforeInsertHooks, heatmapHook)
+		heatmapBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		heatmapAfterInsertMu.Lock()
+		heatmapAfterInsertHooks = append(heatmapAfterInsertHooks, heatmapHook)
+		heatmapAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		heatmapBeforeUpdateMu.Lock()
+		heatmapBeforeUpdateHooks = append(heatmapBeforeUpdateHooks, heatmapHook)
+		heatmapBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		heatmapAfterUpdateMu.Lock()
+		heatmapAfterUpdateHooks = append(heatmapAfterUpdateHooks, heatmapHook)
+		heatmapAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		heatmapBeforeDeleteMu.Lock()
+		heatmapBeforeDeleteHooks = append(heatmapBeforeDeleteHooks, heatmapHook)
+		heatmapBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		heatmapAfterDeleteMu.Lock()
+		heatmapAfterDeleteHooks = append(heatmapAfterDeleteHooks, heatmapHook)
+		heatmapAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		heatmapBeforeUpsertMu.Lock()
+		heatmapBeforeUpsertHooks = append(heatmapBeforeUpsertHooks, heatmapHook)
+		heatmapBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		heatmapAfterUpsertMu.Lock()
+		heatmapAfterUpsertHooks = append(heatmapAfterUpsertHooks, heatmapHook)
+		heatmapAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single heatmap record from the query using the global executor.
+func (q heatmapQuery) OneG(ctx context.Context) (*Heatmap, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single heatmap record from the query.
+func (q heatmapQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Heatmap, error) {
+	o := &Heatmap{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for heatmap")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Heatmap records from the query using the global executor.
+func (q heatmapQuery) AllG(ctx context.Context) (HeatmapSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Heatmap records from the query.
+func (q heatmapQuery) All(ctx context.Context, exec boil.ContextExecutor) (HeatmapSlice, error) {
+	var o []*Heatmap
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to Heatmap slice")
+	}
+
+	if len(heatmapAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Heatmap records in the query using the global executor
+func (q heatmapQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Heatmap records in the query.
+func (q heatmapQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count heatmap rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q heatmapQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q heatmapQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if heatmap exists")
+	}
+
+	return count > 0, nil
+}
+
+// Heatmaps retrieves all the records using an executor.
+func Heatmaps(mods ...qm.QueryMod) heatmapQuery {
+	mods = append(mods, qm.From("\"xovis2\".\"heatmap\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"xovis2\".\"heatmap\".*"})
+	}
+
+	return heatmapQuery{q}
+}
+
+// FindHeatmapG retrieves a single record by ID.
+func FindHeatmapG(ctx context.Context, iD int64, selectCols ...string) (*Heatmap, error) {
+	return FindHeatmap(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindHeatmap retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindHeatmap(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*Heatmap, error) {
+	heatmapObj := &Heatmap{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"xovis2\".\"heatmap\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, heatmapObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from heatmap")
+	}
+
+	if err = heatmapObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return heatmapObj, err
+	}
+
+	return heatmapObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Heatmap) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Heatmap) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no heatmap provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(heatmapColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	heatmapInsertCacheMut.RLock()
+	cache, cached := heatmapInsertCache[key]
+	heatmapInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			heatmapAllColumns,
+			heatmapColumnsWithDefault,
+			heatmapColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(heatmapType, heatmapMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(heatmapType, heatmapMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"xovis2\".\"heatmap\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"xovis2\".\"heatmap\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into heatmap")
+	}
+
+	if !cached {
+		heatmapInsertCacheMut.Lock()
+		heatmapInsertCache[key] = cache
+		heatmapInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single Heatmap record using the global executor.
+// See Update for more documentation.
+func (o *Heatmap) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Heatmap.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Heatmap) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	heatmapUpdateCacheMut.RLock()
+	cache, cached := heatmapUpdateCache[key]
+	heatmapUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			heatmapAllColumns,
+			heatmapPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update heatmap, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"xovis2\".\"heatmap\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, heatmapPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(heatmapType, heatmapMapping, append(wl, heatmapPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update heatmap row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for heatmap")
+	}
+
+	if !cached {
+		heatmapUpdateCacheMut.Lock()
+		heatmapUpdateCache[key] = cache
+		heatmapUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q heatmapQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q heatmapQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for heatmap")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for heatmap")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o HeatmapSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o HeatmapSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), heatmapPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"xovis2\".\"heatmap\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, heatmapPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in heatmap slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all heatmap")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Heatmap) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Heatmap) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no heatmap provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(heatmapColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	heatmapUpsertCacheMut.RLock()
+	cache, cached := heatmapUpsertCache[key]
+	heatmapUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			heatmapAllColumns,
+			heatmapColumnsWithDefault,
+			heatmapColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			heatmapAllColumns,
+			heatmapPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert heatmap, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(heatmapAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(heatmapPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert heatmap, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(heatmapPrimaryKeyColumns))
+			copy(conflict, heatmapPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"xovis2\".\"heatmap\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(heatmapType, heatmapMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(heatmapType, heatmapMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert heatmap")
+	}
+
+	if !cached {
+		heatmapUpsertCacheMut.Lock()
+		heatmapUpsertCache[key] = cache
+		heatmapUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single Heatmap record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Heatmap) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Heatmap record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Heatmap) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no Heatmap provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), heatmapPrimaryKeyMapping)
+	sql := "DELETE FROM \"xovis2\".\"heatmap\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from heatmap")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for heatmap")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q heatmapQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q heatmapQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no heatmapQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from heatmap")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for heatmap")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o HeatmapSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o HeatmapSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(heatmapBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), heatmapPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"xovis2\".\"heatmap\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, heatmapPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from heatmap slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for heatmap")
+	}
+
+	if len(heatmapAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Heatmap) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no Heatmap provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Heatmap) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindHeatmap(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *HeatmapSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty HeatmapSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *HeatmapSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := HeatmapSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), heatmapPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"xovis2\".\"heatmap\".* FROM \"xovis2\".\"heatmap\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, heatmapPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in HeatmapSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// HeatmapExistsG checks if the Heatmap row exists.
+func HeatmapExistsG(ctx context.Context, iD int64) (bool, error) {
+	return HeatmapExists(ctx, boil.GetContextDB(), iD)
+}
+
+// HeatmapExists checks if the Heatmap row exists.
+func HeatmapExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"xovis2\".\"heatmap\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if heatmap exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Heatmap row exists.
+func (o *Heatmap) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return HeatmapExists(ctx, exec, o.ID)
+}
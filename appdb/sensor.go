@@ -19,154 +19,202 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"github.com/volatiletech/strmangle"
 )
 
 // Sensor is an object representing the database table.
 type Sensor struct {
-	ID              int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	ConfigurationID int64       `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
-	Username        string      `boil:"username" json:"username" toml:"username" yaml:"username"`
-	Password        string      `boil:"password" json:"password" toml:"password" yaml:"password"`
-	Hostname        string      `boil:"hostname" json:"hostname" toml:"hostname" yaml:"hostname"`
-	Port            int32       `boil:"port" json:"port" toml:"port" yaml:"port"`
-	DiscoveryMode   string      `boil:"discovery_mode" json:"discovery_mode" toml:"discovery_mode" yaml:"discovery_mode"`
-	L3FirstIP       null.String `boil:"l3_first_ip" json:"l3_first_ip,omitempty" toml:"l3_first_ip" yaml:"l3_first_ip,omitempty"`
-	L3Count         null.Int32  `boil:"l3_count" json:"l3_count,omitempty" toml:"l3_count" yaml:"l3_count,omitempty"`
-	MacAddress      null.String `boil:"mac_address" json:"mac_address,omitempty" toml:"mac_address" yaml:"mac_address,omitempty"`
+	ID                int64              `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID   int64              `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	Username          string             `boil:"username" json:"username" toml:"username" yaml:"username"`
+	Password          string             `boil:"password" json:"password" toml:"password" yaml:"password"`
+	Hostname          string             `boil:"hostname" json:"hostname" toml:"hostname" yaml:"hostname"`
+	Port              int32              `boil:"port" json:"port" toml:"port" yaml:"port"`
+	DiscoveryMode     string             `boil:"discovery_mode" json:"discovery_mode" toml:"discovery_mode" yaml:"discovery_mode"`
+	L3FirstIP         null.String        `boil:"l3_first_ip" json:"l3_first_ip,omitempty" toml:"l3_first_ip" yaml:"l3_first_ip,omitempty"`
+	L3Count           null.Int32         `boil:"l3_count" json:"l3_count,omitempty" toml:"l3_count" yaml:"l3_count,omitempty"`
+	MacAddress        null.String        `boil:"mac_address" json:"mac_address,omitempty" toml:"mac_address" yaml:"mac_address,omitempty"`
+	GroupName         null.String        `boil:"group_name" json:"group_name,omitempty" toml:"group_name" yaml:"group_name,omitempty"`
+	ProjectIds        types.StringArray  `boil:"project_ids" json:"project_ids,omitempty" toml:"project_ids" yaml:"project_ids,omitempty"`
+	Status            string             `boil:"status" json:"status" toml:"status" yaml:"status"`
+	StatusMessage     null.String        `boil:"status_message" json:"status_message,omitempty" toml:"status_message" yaml:"status_message,omitempty"`
+	LastSeen          null.Time          `boil:"last_seen" json:"last_seen,omitempty" toml:"last_seen" yaml:"last_seen,omitempty"`
+	ParentAssetID     null.Int32         `boil:"parent_asset_id" json:"parent_asset_id,omitempty" toml:"parent_asset_id" yaml:"parent_asset_id,omitempty"`
+	Tag               null.String        `boil:"tag" json:"tag,omitempty" toml:"tag" yaml:"tag,omitempty"`
+	GeometryTransform types.Float64Array `boil:"geometry_transform" json:"geometry_transform,omitempty" toml:"geometry_transform" yaml:"geometry_transform,omitempty"`
+	CertFingerprint   null.String        `boil:"cert_fingerprint" json:"cert_fingerprint,omitempty" toml:"cert_fingerprint" yaml:"cert_fingerprint,omitempty"`
+	ConnectHostname   null.String        `boil:"connect_hostname" json:"connect_hostname,omitempty" toml:"connect_hostname" yaml:"connect_hostname,omitempty"`
+	ConnectPort       null.Int32         `boil:"connect_port" json:"connect_port,omitempty" toml:"connect_port" yaml:"connect_port,omitempty"`
 
 	R *sensorR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L sensorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var SensorColumns = struct {
-	ID              string
-	ConfigurationID string
-	Username        string
-	Password        string
-	Hostname        string
-	Port            string
-	DiscoveryMode   string
-	L3FirstIP       string
-	L3Count         string
-	MacAddress      string
+	ID                string
+	ConfigurationID   string
+	Username          string
+	Password          string
+	Hostname          string
+	Port              string
+	DiscoveryMode     string
+	L3FirstIP         string
+	L3Count           string
+	MacAddress        string
+	GroupName         string
+	ProjectIds        string
+	Status            string
+	StatusMessage     string
+	LastSeen          string
+	ParentAssetID     string
+	Tag               string
+	GeometryTransform string
+	CertFingerprint   string
+	ConnectHostname   string
+	ConnectPort       string
 }{
-	ID:              "id",
-	ConfigurationID: "configuration_id",
-	Username:        "username",
-	Password:        "password",
-	Hostname:        "hostname",
-	Port:            "port",
-	DiscoveryMode:   "discovery_mode",
-	L3FirstIP:       "l3_first_ip",
-	L3Count:         "l3_count",
-	MacAddress:      "mac_address",
+	ID:                "id",
+	ConfigurationID:   "configuration_id",
+	Username:          "username",
+	Password:          "password",
+	Hostname:          "hostname",
+	Port:              "port",
+	DiscoveryMode:     "discovery_mode",
+	L3FirstIP:         "l3_first_ip",
+	L3Count:           "l3_count",
+	MacAddress:        "mac_address",
+	GroupName:         "group_name",
+	ProjectIds:        "project_ids",
+	Status:            "status",
+	StatusMessage:     "status_message",
+	LastSeen:          "last_seen",
+	ParentAssetID:     "parent_asset_id",
+	Tag:               "tag",
+	GeometryTransform: "geometry_transform",
+	CertFingerprint:   "cert_fingerprint",
+	ConnectHostname:   "connect_hostname",
+	ConnectPort:       "connect_port",
 }
 
 var SensorTableColumns = struct {
-	ID              string
-	ConfigurationID string
-	Username        string
-	Password        string
-	Hostname        string
-	Port            string
-	DiscoveryMode   string
-	L3FirstIP       string
-	L3Count         string
-	MacAddress      string
+	ID                string
+	ConfigurationID   string
+	Username          string
+	Password          string
+	Hostname          string
+	Port              string
+	DiscoveryMode     string
+	L3FirstIP         string
+	L3Count           string
+	MacAddress        string
+	GroupName         string
+	ProjectIds        string
+	Status            string
+	StatusMessage     string
+	LastSeen          string
+	ParentAssetID     string
+	Tag               string
+	GeometryTransform string
+	CertFingerprint   string
+	ConnectHostname   string
+	ConnectPort       string
 }{
-	ID:              "sensor.id",
-	ConfigurationID: "sensor.configuration_id",
-	Username:        "sensor.username",
-	Password:        "sensor.password",
-	Hostname:        "sensor.hostname",
-	Port:            "sensor.port",
-	DiscoveryMode:   "sensor.discovery_mode",
-	L3FirstIP:       "sensor.l3_first_ip",
-	L3Count:         "sensor.l3_count",
-	MacAddress:      "sensor.mac_address",
+	ID:                "sensor.id",
+	ConfigurationID:   "sensor.configuration_id",
+	Username:          "sensor.username",
+	Password:          "sensor.password",
+	Hostname:          "sensor.hostname",
+	Port:              "sensor.port",
+	DiscoveryMode:     "sensor.discovery_mode",
+	L3FirstIP:         "sensor.l3_first_ip",
+	L3Count:           "sensor.l3_count",
+	MacAddress:        "sensor.mac_address",
+	GroupName:         "sensor.group_name",
+	ProjectIds:        "sensor.project_ids",
+	Status:            "sensor.status",
+	StatusMessage:     "sensor.status_message",
+	LastSeen:          "sensor.last_seen",
+	ParentAssetID:     "sensor.parent_asset_id",
+	Tag:               "sensor.tag",
+	GeometryTransform: "sensor.geometry_transform",
+	CertFingerprint:   "sensor.cert_fingerprint",
+	ConnectHostname:   "sensor.connect_hostname",
+	ConnectPort:       "sensor.connect_port",
 }
 
 // Generated where
 
-type whereHelpernull_String struct{ field string }
+type whereHelpertypes_Float64Array struct{ field string }
 
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+func (w whereHelpertypes_Float64Array) EQ(x types.Float64Array) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+func (w whereHelpertypes_Float64Array) NEQ(x types.Float64Array) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+func (w whereHelpertypes_Float64Array) LT(x types.Float64Array) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+func (w whereHelpertypes_Float64Array) LTE(x types.Float64Array) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+func (w whereHelpertypes_Float64Array) GT(x types.Float64Array) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+func (w whereHelpertypes_Float64Array) GTE(x types.Float64Array) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
-func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" LIKE ?", x)
-}
-func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT LIKE ?", x)
-}
-func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" ILIKE ?", x)
-}
-func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT ILIKE ?", x)
-}
-func (w whereHelpernull_String) SIMILAR(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" SIMILAR TO ?", x)
-}
-func (w whereHelpernull_String) NSIMILAR(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
-}
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
 
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpertypes_Float64Array) IsNull() qm.QueryMod { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpertypes_Float64Array) IsNotNull() qm.QueryMod {
+	return qmhelper.WhereIsNotNull(w.field)
+}
 
 var SensorWhere = struct {
-	ID              whereHelperint64
-	ConfigurationID whereHelperint64
-	Username        whereHelperstring
-	Password        whereHelperstring
-	Hostname        whereHelperstring
-	Port            whereHelperint32
-	DiscoveryMode   whereHelperstring
-	L3FirstIP       whereHelpernull_String
-	L3Count         whereHelpernull_Int32
-	MacAddress      whereHelpernull_String
+	ID                whereHelperint64
+	ConfigurationID   whereHelperint64
+	Username          whereHelperstring
+	Password          whereHelperstring
+	Hostname          whereHelperstring
+	Port              whereHelperint32
+	DiscoveryMode     whereHelperstring
+	L3FirstIP         whereHelpernull_String
+	L3Count           whereHelpernull_Int32
+	MacAddress        whereHelpernull_String
+	GroupName         whereHelpernull_String
+	ProjectIds        whereHelpertypes_StringArray
+	Status            whereHelperstring
+	StatusMessage     whereHelpernull_String
+	LastSeen          whereHelpernull_Time
+	ParentAssetID     whereHelpernull_Int32
+	Tag               whereHelpernull_String
+	GeometryTransform whereHelpertypes_Float64Array
+	CertFingerprint   whereHelpernull_String
+	ConnectHostname   whereHelpernull_String
+	ConnectPort       whereHelpernull_Int32
 }{
-	ID:              whereHelperint64{field: "\"xovis2\".\"sensor\".\"id\""},
-	ConfigurationID: whereHelperint64{field: "\"xovis2\".\"sensor\".\"configuration_id\""},
-	Username:        whereHelperstring{field: "\"xovis2\".\"sensor\".\"username\""},
-	Password:        whereHelperstring{field: "\"xovis2\".\"sensor\".\"password\""},
-	Hostname:        whereHelperstring{field: "\"xovis2\".\"sensor\".\"hostname\""},
-	Port:            whereHelperint32{field: "\"xovis2\".\"sensor\".\"port\""},
-	DiscoveryMode:   whereHelperstring{field: "\"xovis2\".\"sensor\".\"discovery_mode\""},
-	L3FirstIP:       whereHelpernull_String{field: "\"xovis2\".\"sensor\".\"l3_first_ip\""},
-	L3Count:         whereHelpernull_Int32{field: "\"xovis2\".\"sensor\".\"l3_count\""},
-	MacAddress:      whereHelpernull_String{field: "\"xovis2\".\"sensor\".\"mac_address\""},
+	ID:                whereHelperint64{field: "\"xovis2\".\"sensor\".\"id\""},
+	ConfigurationID:   whereHelperint64{field: "\"xovis2\".\"sensor\".\"configuration_id\""},
+	Username:          whereHelperstring{field: "\"xovis2\".\"sensor\".\"username\""},
+	Password:          whereHelperstring{field: "\"xovis2\".\"sensor\".\"password\""},
+	Hostname:          whereHelperstring{field: "\"xovis2\".\"sensor\".\"hostname\""},
+	Port:              whereHelperint32{field: "\"xovis2\".\"sensor\".\"port\""},
+	DiscoveryMode:     whereHelperstring{field: "\"xovis2\".\"sensor\".\"discovery_mode\""},
+	L3FirstIP:         whereHelpernull_String{field: "\"xovis2\".\"sensor\".\"l3_first_ip\""},
+	L3Count:           whereHelpernull_Int32{field: "\"xovis2\".\"sensor\".\"l3_count\""},
+	MacAddress:        whereHelpernull_String{field: "\"xovis2\".\"sensor\".\"mac_address\""},
+	GroupName:         whereHelpernull_String{field: "\"xovis2\".\"sensor\".\"group_name\""},
+	ProjectIds:        whereHelpertypes_StringArray{field: "\"xovis2\".\"sensor\".\"project_ids\""},
+	Status:            whereHelperstring{field: "\"xovis2\".\"sensor\".\"status\""},
+	StatusMessage:     whereHelpernull_String{field: "\"xovis2\".\"sensor\".\"status_message\""},
+	LastSeen:          whereHelpernull_Time{field: "\"xovis2\".\"sensor\".\"last_seen\""},
+	ParentAssetID:     whereHelpernull_Int32{field: "\"xovis2\".\"sensor\".\"parent_asset_id\""},
+	Tag:               whereHelpernull_String{field: "\"xovis2\".\"sensor\".\"tag\""},
+	GeometryTransform: whereHelpertypes_Float64Array{field: "\"xovis2\".\"sensor\".\"geometry_transform\""},
+	CertFingerprint:   whereHelpernull_String{field: "\"xovis2\".\"sensor\".\"cert_fingerprint\""},
+	ConnectHostname:   whereHelpernull_String{field: "\"xovis2\".\"sensor\".\"connect_hostname\""},
+	ConnectPort:       whereHelpernull_Int32{field: "\"xovis2\".\"sensor\".\"connect_port\""},
 }
 
 // SensorRels is where relationship names are stored.
@@ -197,9 +245,9 @@ func (r *sensorR) GetConfiguration() *Configuration {
 type sensorL struct{}
 
 var (
-	sensorAllColumns            = []string{"id", "configuration_id", "username", "password", "hostname", "port", "discovery_mode", "l3_first_ip", "l3_count", "mac_address"}
+	sensorAllColumns            = []string{"id", "configuration_id", "username", "password", "hostname", "port", "discovery_mode", "l3_first_ip", "l3_count", "mac_address", "group_name", "project_ids", "status", "status_message", "last_seen", "parent_asset_id", "tag", "geometry_transform", "cert_fingerprint", "connect_hostname", "connect_port"}
 	sensorColumnsWithoutDefault = []string{"username", "password", "hostname", "port", "discovery_mode"}
-	sensorColumnsWithDefault    = []string{"id", "configuration_id", "l3_first_ip", "l3_count", "mac_address"}
+	sensorColumnsWithDefault    = []string{"id", "configuration_id", "l3_first_ip", "l3_count", "mac_address", "group_name", "project_ids", "status", "status_message", "last_seen", "parent_asset_id", "tag", "geometry_transform", "cert_fingerprint", "connect_hostname", "connect_port"}
 	sensorPrimaryKeyColumns     = []string{"id"}
 	sensorGeneratedColumns      = []string{}
 )
@@ -0,0 +1,1089 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SensorHealth is an object representing the database table.
+type SensorHealth struct {
+	SerialNumber string      `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	Online       null.Bool   `boil:"online" json:"online,omitempty" toml:"online" yaml:"online,omitempty"`
+	Illumination null.String `boil:"illumination" json:"illumination,omitempty" toml:"illumination" yaml:"illumination,omitempty"`
+	Tilted       bool        `boil:"tilted" json:"tilted" toml:"tilted" yaml:"tilted"`
+	Covered      bool        `boil:"covered" json:"covered" toml:"covered" yaml:"covered"`
+	UpdatedAt    time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *sensorHealthR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L sensorHealthL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SensorHealthColumns = struct {
+	SerialNumber string
+	Online       string
+	Illumination string
+	Tilted       string
+	Covered      string
+	UpdatedAt    string
+}{
+	SerialNumber: "serial_number",
+	Online:       "online",
+	Illumination: "illumination",
+	Tilted:       "tilted",
+	Covered:      "covered",
+	UpdatedAt:    "updated_at",
+}
+
+var SensorHealthTableColumns = struct {
+	SerialNumber string
+	Online       string
+	Illumination string
+	Tilted       string
+	Covered      string
+	UpdatedAt    string
+}{
+	SerialNumber: "sensor_health.serial_number",
+	Online:       "sensor_health.online",
+	Illumination: "sensor_health.illumination",
+	Tilted:       "sensor_health.tilted",
+	Covered:      "sensor_health.covered",
+	UpdatedAt:    "sensor_health.updated_at",
+}
+
+// Generated where
+
+type whereHelpernull_Bool struct{ field string }
+
+func (w whereHelpernull_Bool) EQ(x null.Bool) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Bool) NEQ(x null.Bool) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Bool) LT(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Bool) LTE(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Bool) GT(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Bool) GTE(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Bool) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Bool) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var SensorHealthWhere = struct {
+	SerialNumber whereHelperstring
+	Online       whereHelpernull_Bool
+	Illumination whereHelpernull_String
+	Tilted       whereHelperbool
+	Covered      whereHelperbool
+	UpdatedAt    whereHelpertime_Time
+}{
+	SerialNumber: whereHelperstring{field: "\"xovis2\".\"sensor_health\".\"serial_number\""},
+	Online:       whereHelpernull_Bool{field: "\"xovis2\".\"sensor_health\".\"online\""},
+	Illumination: whereHelpernull_String{field: "\"xovis2\".\"sensor_health\".\"illumination\""},
+	Tilted:       whereHelperbool{field: "\"xovis2\".\"sensor_health\".\"tilted\""},
+	Covered:      whereHelperbool{field: "\"xovis2\".\"sensor_health\".\"covered\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"xovis2\".\"sensor_health\".\"updated_at\""},
+}
+
+// SensorHealthRels is where relationship names are stored.
+var SensorHealthRels = struct {
+}{}
+
+// sensorHealthR is where relationships are stored.
+type sensorHealthR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*sensorHealthR) NewStruct() *sensorHealthR {
+	return &sensorHealthR{}
+}
+
+// sensorHealthL is where Load methods for each relationship are stored.
+type sensorHealthL struct{}
+
+var (
+	sensorHealthAllColumns            = []string{"serial_number", "online", "illumination", "tilted", "covered", "updated_at"}
+	sensorHealthColumnsWithoutDefault = []string{"serial_number"}
+	sensorHealthColumnsWithDefault    = []string{"online", "illumination", "tilted", "covered", "updated_at"}
+	sensorHealthPrimaryKeyColumns     = []string{"serial_number"}
+	sensorHealthGeneratedColumns      = []string{}
+)
+
+type (
+	// SensorHealthSlice is an alias for a slice of pointers to SensorHealth.
+	// This should almost always be used instead of []SensorHealth.
+	SensorHealthSlice []*SensorHealth
+	// SensorHealthHook is the signature for custom SensorHealth hook methods
+	SensorHealthHook func(context.Context, boil.ContextExecutor, *SensorHealth) error
+
+	sensorHealthQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	sensorHealthType                 = reflect.TypeOf(&SensorHealth{})
+	sensorHealthMapping              = queries.MakeStructMapping(sensorHealthType)
+	sensorHealthPrimaryKeyMapping, _ = queries.BindMapping(sensorHealthType, sensorHealthMapping, sensorHealthPrimaryKeyColumns)
+	sensorHealthInsertCacheMut       sync.RWMutex
+	sensorHealthInsertCache          = make(map[string]insertCache)
+	sensorHealthUpdateCacheMut       sync.RWMutex
+	sensorHealthUpdateCache          = make(map[string]updateCache)
+	sensorHealthUpsertCacheMut       sync.RWMutex
+	sensorHealthUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var sensorHealthAfterSelectMu sync.Mutex
+var sensorHealthAfterSelectHooks []SensorHealthHook
+
+var sensorHealthBeforeInsertMu sync.Mutex
+var sensorHealthBeforeInsertHooks []SensorHealthHook
+var sensorHealthAfterInsertMu sync.Mutex
+var sensorHealthAfterInsertHooks []SensorHealthHook
+
+var sensorHealthBeforeUpdateMu sync.Mutex
+var sensorHealthBeforeUpdateHooks []SensorHealthHook
+var sensorHealthAfterUpdateMu sync.Mutex
+var sensorHealthAfterUpdateHooks []SensorHealthHook
+
+var sensorHealthBeforeDeleteMu sync.Mutex
+var sensorHealthBeforeDeleteHooks []SensorHealthHook
+var sensorHealthAfterDeleteMu sync.Mutex
+var sensorHealthAfterDeleteHooks []SensorHealthHook
+
+var sensorHealthBeforeUpsertMu sync.Mutex
+var sensorHealthBeforeUpsertHooks []SensorHealthHook
+var sensorHealthAfterUpsertMu sync.Mutex
+var sensorHealthAfterUpsertHooks []SensorHealthHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SensorHealth) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sensorHealthAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SensorHealth) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sensorHealthBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SensorHealth) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sensorHealthAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SensorHealth) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sensorHealthBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SensorHealth) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sensorHealthAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SensorHealth) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sensorHealthBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SensorHealth) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sensorHealthAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SensorHealth) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sensorHealthBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SensorHealth) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sensorHealthAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSensorHealthHook registers your hook function for all future operations.
+func AddSensorHealthHook(hookPoint boil.HookPoint, sensorHealthHook SensorHealthHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		sensorHealthAfterSelectMu.Lock()
+		sensorHealthAfterSelectHooks = append(sensorHealthAfterSelectHooks, sensorHealthHook)
+		sensorHealthAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		sensorHealthBeforeInsertMu.Lock()
+		sensorHealthBeforeInsertHooks = append(sensorHealthBeforeInsertHooks, sensorHealthHook)
+		sensorHealthBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		sensorHealthAfterInsertMu.Lock()
+		sensorHealthAfterInsertHooks = append(sensorHealthAfterInsertHooks, sensorHealthHook)
+		sensorHealthAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		sensorHealthBeforeUpdateMu.Lock()
+		sensorHealthBeforeUpdateHooks = append(sensorHealthBeforeUpdateHooks, sensorHealthHook)
+		sensorHealthBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		sensorHealthAfterUpdateMu.Lock()
+		sensorHealthAfterUpdateHooks = append(sensorHealthAfterUpdateHooks, sensorHealthHook)
+		sensorHealthAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		sensorHealthBeforeDeleteMu.Lock()
+		sensorHealthBeforeDeleteHooks = append(sensorHealthBeforeDeleteHooks, sensorHealthHook)
+		sensorHealthBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		sensorHealthAfterDeleteMu.Lock()
+		sensorHealthAfterDeleteHooks = append(sensorHealthAfterDeleteHooks, sensorHealthHook)
+		sensorHealthAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		sensorHealthBeforeUpsertMu.Lock()
+		sensorHealthBeforeUpsertHooks = append(sensorHealthBeforeUpsertHooks, sensorHealthHook)
+		sensorHealthBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		sensorHealthAfterUpsertMu.Lock()
+		sensorHealthAfterUpsertHooks = append(sensorHealthAfterUpsertHooks, sensorHealthHook)
+		sensorHealthAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single sensorHealth record from the query using the global executor.
+func (q sensorHealthQuery) OneG(ctx context.Context) (*SensorHealth, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single sensorHealth record from the query.
+func (q sensorHealthQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SensorHealth, error) {
+	o := &SensorHealth{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for sensor_health")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all SensorHealth records from the query using the global executor.
+func (q sensorHealthQuery) AllG(ctx context.Context) (SensorHealthSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all SensorHealth records from the query.
+func (q sensorHealthQuery) All(ctx context.Context, exec boil.ContextExecutor) (SensorHealthSlice, error) {
+	var o []*SensorHealth
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to SensorHealth slice")
+	}
+
+	if len(sensorHealthAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all SensorHealth records in the query using the global executor
+func (q sensorHealthQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all SensorHealth records in the query.
+func (q sensorHealthQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count sensor_health rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q sensorHealthQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q sensorHealthQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if sensor_health exists")
+	}
+
+	return count > 0, nil
+}
+
+// SensorHealths retrieves all the records using an executor.
+func SensorHealths(mods ...qm.QueryMod) sensorHealthQuery {
+	mods = append(mods, qm.From("\"xovis2\".\"sensor_health\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"xovis2\".\"sensor_health\".*"})
+	}
+
+	return sensorHealthQuery{q}
+}
+
+// FindSensorHealthG retrieves a single record by ID.
+func FindSensorHealthG(ctx context.Context, serialNumber string, selectCols ...string) (*SensorHealth, error) {
+	return FindSensorHealth(ctx, boil.GetContextDB(), serialNumber, selectCols...)
+}
+
+// FindSensorHealth retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSensorHealth(ctx context.Context, exec boil.ContextExecutor, serialNumber string, selectCols ...string) (*SensorHealth, error) {
+	sensorHealthObj := &SensorHealth{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"xovis2\".\"sensor_health\" where \"serial_number\"=$1", sel,
+	)
+
+	q := queries.Raw(query, serialNumber)
+
+	err := q.Bind(ctx, exec, sensorHealthObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from sensor_health")
+	}
+
+	if err = sensorHealthObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return sensorHealthObj, err
+	}
+
+	return sensorHealthObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *SensorHealth) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SensorHealth) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no sensor_health provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(sensorHealthColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	sensorHealthInsertCacheMut.RLock()
+	cache, cached := sensorHealthInsertCache[key]
+	sensorHealthInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			sensorHealthAllColumns,
+			sensorHealthColumnsWithDefault,
+			sensorHealthColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(sensorHealthType, sensorHealthMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(sensorHealthType, sensorHealthMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"xovis2\".\"sensor_health\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"xovis2\".\"sensor_health\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into sensor_health")
+	}
+
+	if !cached {
+		sensorHealthInsertCacheMut.Lock()
+		sensorHealthInsertCache[key] = cache
+		sensorHealthInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single SensorHealth record using the global executor.
+// See Update for more documentation.
+func (o *SensorHealth) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the SensorHealth.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SensorHealth) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	sensorHealthUpdateCacheMut.RLock()
+	cache, cached := sensorHealthUpdateCache[key]
+	sensorHealthUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			sensorHealthAllColumns,
+			sensorHealthPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update sensor_health, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"xovis2\".\"sensor_health\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, sensorHealthPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(sensorHealthType, sensorHealthMapping, append(wl, sensorHealthPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update sensor_health row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for sensor_health")
+	}
+
+	if !cached {
+		sensorHealthUpdateCacheMut.Lock()
+		sensorHealthUpdateCache[key] = cache
+		sensorHealthUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q sensorHealthQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q sensorHealthQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for sensor_health")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for sensor_health")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o SensorHealthSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SensorHealthSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), sensorHealthPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"xovis2\".\"sensor_health\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, sensorHealthPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in sensorHealth slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all sensorHealth")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *SensorHealth) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SensorHealth) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no sensor_health provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(sensorHealthColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	sensorHealthUpsertCacheMut.RLock()
+	cache, cached := sensorHealthUpsertCache[key]
+	sensorHealthUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			sensorHealthAllColumns,
+			sensorHealthColumnsWithDefault,
+			sensorHealthColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			sensorHealthAllColumns,
+			sensorHealthPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert sensor_health, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(sensorHealthAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(sensorHealthPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert sensor_health, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(sensorHealthPrimaryKeyColumns))
+			copy(conflict, sensorHealthPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"xovis2\".\"sensor_health\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(sensorHealthType, sensorHealthMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(sensorHealthType, sensorHealthMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert sensor_health")
+	}
+
+	if !cached {
+		sensorHealthUpsertCacheMut.Lock()
+		sensorHealthUpsertCache[key] = cache
+		sensorHealthUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single SensorHealth record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *SensorHealth) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single SensorHealth record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SensorHealth) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no SensorHealth provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), sensorHealthPrimaryKeyMapping)
+	sql := "DELETE FROM \"xovis2\".\"sensor_health\" WHERE \"serial_number\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from sensor_health")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for sensor_health")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q sensorHealthQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q sensorHealthQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no sensorHealthQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from sensor_health")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for sensor_health")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o SensorHealthSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SensorHealthSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(sensorHealthBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), sensorHealthPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"xovis2\".\"sensor_health\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, sensorHealthPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from sensorHealth slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for sensor_health")
+	}
+
+	if len(sensorHealthAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *SensorHealth) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no SensorHealth provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SensorHealth) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSensorHealth(ctx, exec, o.SerialNumber)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SensorHealthSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty SensorHealthSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SensorHealthSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SensorHealthSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), sensorHealthPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"xovis2\".\"sensor_health\".* FROM \"xovis2\".\"sensor_health\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, sensorHealthPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in SensorHealthSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SensorHealthExistsG checks if the SensorHealth row exists.
+func SensorHealthExistsG(ctx context.Context, serialNumber string) (bool, error) {
+	return SensorHealthExists(ctx, boil.GetContextDB(), serialNumber)
+}
+
+// SensorHealthExists checks if the SensorHealth row exists.
+func SensorHealthExists(ctx context.Context, exec boil.ContextExecutor, serialNumber string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"xovis2\".\"sensor_health\" where \"serial_number\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, serialNumber)
+	}
+	row := exec.QueryRowContext(ctx, sql, serialNumber)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if sensor_health exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SensorHealth row exists.
+func (o *SensorHealth) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SensorHealthExists(ctx, exec, o.SerialNumber)
+}
@@ -0,0 +1,201 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrUnsupportedFirmware is returned if the sensor provides none of the
+// supported API versions.
+var ErrUnsupportedFirmware = errors.New("unsupported firmware")
+
+// APIVersion is the set of endpoints of one version of the sensor's API.
+// Endpoints a version does not provide are empty.
+type APIVersion struct {
+	Name   string
+	Prefix string
+
+	// Firmware generation that introduced this API version.
+	MinFirmwareMajor int
+
+	DeviceInfo   string
+	DeviceID     string
+	Logics       string
+	ResetCounts  string
+	NetworkState string
+	DeviceState  string
+	TimeState    string
+	DiscoverL2   string
+	DiscoverL3   string
+}
+
+// Path returns the full path of an endpoint of this version.
+func (v APIVersion) Path(endpoint string) string {
+	return v.Prefix + endpoint
+}
+
+// APIv5 is provided by firmware 5.0 and newer.
+var APIv5 = APIVersion{
+	Name:             "v5",
+	Prefix:           "/api/v5",
+	MinFirmwareMajor: 5,
+	DeviceInfo:       "/device/info",
+	DeviceID:         "/device/id",
+	Logics:           "/singlesensor/data/live/logics",
+	ResetCounts:      "/singlesensor/data/live/counts/reset",
+	NetworkState:     "/network/state",
+	DeviceState:      "/device/state",
+	TimeState:        "/time/state",
+	DiscoverL2:       "/discover/localnetwork",
+	DiscoverL3:       "/discover/scan",
+}
+
+// APIv4 is provided by firmware 4.x. It reports the logics with the
+// XLT_4X_* types, lacks the product code in the device info and provides
+// neither the state endpoints nor discovery.
+var APIv4 = APIVersion{
+	Name:             "v4",
+	Prefix:           "/api/v4",
+	MinFirmwareMajor: 4,
+	DeviceInfo:       "/device/info",
+	DeviceID:         "/device/id",
+	Logics:           "/singlesensor/data/live/logics",
+	ResetCounts:      "/singlesensor/data/live/counts/reset",
+}
+
+// apiVersions are probed in this order, the newest first.
+var apiVersions = []APIVersion{APIv5, APIv4}
+
+// endpointLabel returns the path without the version prefix, so requests to
+// sensors of different API versions share the metrics labels.
+func endpointLabel(apiPath string) string {
+	for _, version := range apiVersions {
+		if endpoint, found := strings.CutPrefix(apiPath, version.Prefix); found {
+			return endpoint
+		}
+	}
+	return apiPath
+}
+
+// negotiatedAPI is the API version negotiated with a sensor and the firmware
+// it was negotiated for.
+type negotiatedAPI struct {
+	version  APIVersion
+	firmware string
+}
+
+var (
+	negotiatedMu sync.Mutex
+	negotiated   = make(map[string]negotiatedAPI)
+)
+
+// negotiatedFor returns the API version negotiated with the sensor at the
+// address. Connectors are created for each cycle, so the version outlives them.
+func negotiatedFor(address string) (negotiatedAPI, bool) {
+	negotiatedMu.Lock()
+	defer negotiatedMu.Unlock()
+	api, ok := negotiated[address]
+	return api, ok
+}
+
+func setNegotiated(address string, api negotiatedAPI) {
+	negotiatedMu.Lock()
+	defer negotiatedMu.Unlock()
+	negotiated[address] = api
+}
+
+func forgetNegotiated(address string) {
+	negotiatedMu.Lock()
+	defer negotiatedMu.Unlock()
+	delete(negotiated, address)
+}
+
+// negotiateAPI selects the newest API version the sensor provides. Sensors
+// answer the device info of versions they do not provide with 404, so the
+// versions are probed from the newest to the oldest. The version is kept for
+// the sensor and negotiated again once its firmware changes.
+func (x *Xovis) negotiateAPI(ctx context.Context) (*APIVersion, deviceInfoResponse, error) {
+	address := net.JoinHostPort(x.http.host, x.http.port)
+	if api, ok := negotiatedFor(address); ok {
+		info, err := x.requestDeviceInfo(ctx, api.version)
+		var statusErr *StatusError
+		if err != nil && !(errors.As(err, &statusErr) && statusErr.Code == http.StatusNotFound) {
+			return nil, deviceInfoResponse{}, err
+		}
+		if err == nil && info.FWVersion == api.firmware {
+			return &api.version, info, nil
+		}
+		forgetNegotiated(address)
+	}
+
+	for _, version := range apiVersions {
+		info, err := x.requestDeviceInfo(ctx, version)
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) && statusErr.Code == http.StatusNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, deviceInfoResponse{}, err
+		}
+		if major, err := strconv.Atoi(strings.SplitN(info.FWVersion, ".", 2)[0]); err == nil && major < version.MinFirmwareMajor {
+			return nil, info, fmt.Errorf("%w: firmware %s is older than the API %s", ErrUnsupportedFirmware, info.FWVersion, version.Name)
+		}
+		setNegotiated(address, negotiatedAPI{version: version, firmware: info.FWVersion})
+		return &version, info, nil
+	}
+	return nil, deviceInfoResponse{}, fmt.Errorf("%w: the sensor provides none of the API versions %s, at least firmware %d.0 is required",
+		ErrUnsupportedFirmware, supportedAPIVersions(), APIv4.MinFirmwareMajor)
+}
+
+// requestDeviceInfo reads the device info of the API version.
+func (x *Xovis) requestDeviceInfo(ctx context.Context, version APIVersion) (deviceInfoResponse, error) {
+	resp, err := x.http.Request(ctx, http.MethodGet, version.Path(version.DeviceInfo), nil)
+	if err != nil {
+		return deviceInfoResponse{}, fmt.Errorf("making request to get device info: %w", err)
+	}
+	var info deviceInfoResponse
+	if err := json.Unmarshal(resp, &info); err != nil {
+		return deviceInfoResponse{}, fmt.Errorf("parsing device info response: %w\nResponse: %s", err, string(resp))
+	}
+	return info, nil
+}
+
+func supportedAPIVersions() string {
+	names := make([]string, len(apiVersions))
+	for i, version := range apiVersions {
+		names[i] = version.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// apiVersion returns the API version of the sensor, negotiating it on first use.
+func (x *Xovis) apiVersion(ctx context.Context) (*APIVersion, error) {
+	if x.api == nil {
+		if _, err := x.getDeviceInfo(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return x.api, nil
+}
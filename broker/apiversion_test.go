@@ -0,0 +1,137 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+// versionedSensor answers the device info of the API versions it provides
+// with its firmware version, and counts the requests per path.
+type versionedSensor struct {
+	mu        sync.Mutex
+	firmware  string
+	provides  map[string]bool // prefixes of the provided API versions
+	requested map[string]int
+}
+
+func (s *versionedSensor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requested[r.URL.Path]++
+	for prefix := range s.provides {
+		if r.URL.Path == prefix+"/device/info" {
+			fmt.Fprintf(w, `{"serial": "00:11:22:33:44:55", "type": "PC2SE", "fw_version": %q}`, s.firmware)
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+func (s *versionedSensor) set(firmware string, prefixes ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.firmware = firmware
+	s.provides = make(map[string]bool)
+	for _, prefix := range prefixes {
+		s.provides[prefix] = true
+	}
+	s.requested = make(map[string]int)
+}
+
+func (s *versionedSensor) requests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requested[path]
+}
+
+func TestNegotiateAPI(t *testing.T) {
+	tests := []struct {
+		name        string
+		firmware    string
+		provides    []string
+		wantVersion string
+		wantErr     error
+	}{
+		{name: "v5", firmware: "5.2.1", provides: []string{"/api/v5", "/api/v4"}, wantVersion: "v5"},
+		{name: "v4", firmware: "4.6.0", provides: []string{"/api/v4"}, wantVersion: "v4"},
+		{name: "no API version", firmware: "3.9.0", wantErr: ErrUnsupportedFirmware},
+		{name: "firmware older than the API", firmware: "4.6.0", provides: []string{"/api/v5"}, wantErr: ErrUnsupportedFirmware},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, sensor := startTLSSensor(t)
+			fake := &versionedSensor{}
+			fake.set(tt.firmware, tt.provides...)
+			server.Config.Handler = fake
+
+			api, info, err := NewXovisConnector(sensor).negotiateAPI(context.Background())
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("negotiateAPI() error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("negotiateAPI(): %v", err)
+			}
+			if api.Name != tt.wantVersion {
+				t.Errorf("API %s, want %s", api.Name, tt.wantVersion)
+			}
+			if info.FWVersion != tt.firmware {
+				t.Errorf("firmware %s, want %s", info.FWVersion, tt.firmware)
+			}
+		})
+	}
+}
+
+func TestNegotiateAPIKeepsVersion(t *testing.T) {
+	server, sensor := startTLSSensor(t)
+	fake := &versionedSensor{}
+	fake.set("4.6.0", "/api/v4")
+	server.Config.Handler = fake
+
+	for range 3 {
+		api, _, err := NewXovisConnector(sensor).negotiateAPI(context.Background())
+		if err != nil {
+			t.Fatalf("negotiateAPI(): %v", err)
+		}
+		if api.Name != "v4" {
+			t.Fatalf("API %s, want v4", api.Name)
+		}
+	}
+	if got := fake.requests("/api/v5/device/info"); got != 1 {
+		t.Errorf("v5 probed %d times, want once", got)
+	}
+	if got := fake.requests("/api/v4/device/info"); got != 3 {
+		t.Errorf("v4 device info read %d times, want 3", got)
+	}
+
+	// An update of the firmware negotiates the version again.
+	fake.set("5.0.0", "/api/v5", "/api/v4")
+	api, _, err := NewXovisConnector(sensor).negotiateAPI(context.Background())
+	if err != nil {
+		t.Fatalf("negotiateAPI() after the update: %v", err)
+	}
+	if api.Name != "v5" {
+		t.Errorf("API after the update %s, want v5", api.Name)
+	}
+}
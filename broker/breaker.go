@@ -0,0 +1,132 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+	"xovis/metrics"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+const (
+	// Consecutive failed requests after which a sensor is skipped.
+	breakerThreshold = 3
+	breakerCoolDown  = 5 * time.Minute
+)
+
+// ErrCircuitOpen is returned for requests to a sensor that is skipped after
+// repeatedly failing.
+var ErrCircuitOpen = errors.New("sensor skipped")
+
+// circuitBreaker skips a sensor that does not answer for a cool-down period,
+// so collecting does not wait for its timeouts every cycle. After the
+// cool-down, a single request is let through: if it succeeds, the sensor is
+// used again, otherwise it is skipped for another cool-down.
+//
+// A nil breaker lets all requests through.
+type circuitBreaker struct {
+	address string
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+var (
+	breakersMu sync.Mutex
+	breakers   = make(map[string]*circuitBreaker)
+)
+
+// breakerFor returns the breaker of the sensor at the address. Connectors are
+// created for each cycle, so the breakers outlive them.
+func breakerFor(address string) *circuitBreaker {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	breaker, ok := breakers[address]
+	if !ok {
+		breaker = &circuitBreaker{address: address}
+		breakers[address] = breaker
+	}
+	return breaker
+}
+
+func (b *circuitBreaker) allow() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < breakerThreshold {
+		return nil
+	}
+	if time.Now().Before(b.openUntil) || b.probing {
+		return fmt.Errorf("%w until %s after %d failed requests", ErrCircuitOpen, b.openUntil.Format(time.TimeOnly), b.failures)
+	}
+	b.probing = true
+	return nil
+}
+
+// record updates the breaker with the result of a request. Only errors
+// showing that the sensor is unavailable count as failures; a sensor that
+// answers, even with an error, is alive.
+func (b *circuitBreaker) record(sensor string, err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	if err == nil || !unavailable(err) {
+		if b.failures >= breakerThreshold {
+			log.Info(module, "sensor %s answers again", b.address)
+			metrics.SensorCircuitOpen.WithLabelValues(sensor).Set(0)
+		}
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= breakerThreshold {
+		b.openUntil = time.Now().Add(breakerCoolDown)
+		log.Warn(module, "skipping sensor %s until %s after %d failed requests: %v", b.address, b.openUntil.Format(time.TimeOnly), b.failures, err)
+		metrics.SensorCircuitOpen.WithLabelValues(sensor).Set(1)
+	}
+}
+
+func unavailable(err error) bool {
+	var mismatchErr *CertificateMismatchError
+	if errors.As(err, &mismatchErr) {
+		return false
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.Code {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	return true
+}
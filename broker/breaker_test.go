@@ -0,0 +1,93 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package broker
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var errUnreachable = errors.New("dial tcp 10.0.0.12:443: connect: no route to host")
+
+// openBreaker returns a breaker opened by failed requests, whose cool-down
+// has passed.
+func openBreaker(t *testing.T) *circuitBreaker {
+	t.Helper()
+	b := &circuitBreaker{address: "10.0.0.12:443"}
+	for i := range breakerThreshold {
+		if err := b.allow(); err != nil {
+			t.Fatalf("request %d not allowed before the breaker opened: %v", i+1, err)
+		}
+		b.record("sensor", errUnreachable)
+	}
+	if err := b.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("request allowed by the open breaker: %v", err)
+	}
+	if until := time.Until(b.openUntil); until <= 0 || until > breakerCoolDown {
+		t.Fatalf("breaker open for %v, want up to %v", until, breakerCoolDown)
+	}
+	b.openUntil = time.Now().Add(-time.Second)
+	return b
+}
+
+func TestCircuitBreakerCloses(t *testing.T) {
+	b := openBreaker(t)
+
+	// A single probe is let through after the cool-down.
+	if err := b.allow(); err != nil {
+		t.Fatalf("probe not allowed after the cool-down: %v", err)
+	}
+	if err := b.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("second request allowed while probing: %v", err)
+	}
+
+	// An answer, even an error, closes the breaker.
+	b.record("sensor", &StatusError{Code: http.StatusNotFound})
+	for i := range breakerThreshold + 1 {
+		if err := b.allow(); err != nil {
+			t.Fatalf("request %d not allowed by the closed breaker: %v", i+1, err)
+		}
+	}
+	b.record("sensor", errUnreachable)
+	if err := b.allow(); err != nil {
+		t.Fatalf("failures before the breaker closed counted: %v", err)
+	}
+}
+
+func TestCircuitBreakerProbeFails(t *testing.T) {
+	b := openBreaker(t)
+
+	if err := b.allow(); err != nil {
+		t.Fatalf("probe not allowed after the cool-down: %v", err)
+	}
+	b.record("sensor", &StatusError{Code: http.StatusServiceUnavailable})
+	if err := b.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("request allowed after the probe failed: %v", err)
+	}
+	if until := time.Until(b.openUntil); until <= 0 {
+		t.Fatalf("breaker not opened for another cool-down, open until %v", b.openUntil)
+	}
+}
+
+func TestCircuitBreakerNil(t *testing.T) {
+	var b *circuitBreaker
+	b.record("sensor", errUnreachable)
+	if err := b.allow(); err != nil {
+		t.Fatalf("request not allowed by nil breaker: %v", err)
+	}
+}
@@ -16,14 +16,17 @@
 package broker
 
 import (
-	"crypto/tls"
+	"context"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"strconv"
 	"time"
+	"xovis/metrics"
 	assetmodel "xovis/model/asset"
 	confmodel "xovis/model/conf"
 
@@ -36,15 +39,12 @@ const (
 	UserViewer = "viewer"
 	UserAdmin  = "admin"
 
+	// Logic types of firmware 4.x, also reported by newer firmware for
+	// logics migrated from 4.x.
 	InfoTypeLineLegacy = "XLT_4X_LINE_IN_OUT_COUNT"
 	InfoTypeZoneLegacy = "XLT_4X_ZONE_COUNT"
 	InfoTypeLine       = "XLT_LINE_IN_OUT_COUNT"
 	InfoTypeZone       = "XLT_ZONE_OCCUPANCY_COUNT"
-
-	ApiPath = "/api/v5"
-
-	AllCountersPath      = ApiPath + "/singlesensor/data/live/logics"
-	ResetAllCountersPath = ApiPath + "/singlesensor/data/live/counts/reset"
 )
 
 type LineData struct {
@@ -71,23 +71,66 @@ type Zone struct {
 }
 
 type XovisHttp struct {
-	host      string
-	port      string
-	timeout   time.Duration
-	checkCert bool
+	sensor     string // sensor ID, used to label metrics
+	host       string // address connected to, see Sensor.ConnectAddress
+	port       string
+	serverName string // the sensor's own hostname, which its certificate is issued for
+	dialer     sensorDialer
+	timeout    time.Duration
+	checkCert  bool
+	rootCAs    *x509.CertPool // nil for the system's CAs
+	breaker    *circuitBreaker
+
+	pinned      string // fingerprint the certificate must match, empty if not pinned
+	fingerprint string // fingerprint of the certificate presented by the sensor
+}
+
+// Request makes the request, retrying transient failures, and skips the
+// sensor while its circuit breaker is open.
+func (httpClient *XovisHttp) Request(ctx context.Context, method, apiPath string, headers map[string]string) ([]byte, error) {
+	if err := httpClient.breaker.allow(); err != nil {
+		return nil, err
+	}
+	endpoint := endpointLabel(apiPath)
+	var body []byte
+	var err error
+	for attempt := 1; ; attempt++ {
+		start := time.Now()
+		body, err = httpClient.request(ctx, method, apiPath, headers)
+		metrics.SensorRequestDuration.WithLabelValues(httpClient.sensor, endpoint).Observe(time.Since(start).Seconds())
+		if err == nil {
+			break
+		}
+		metrics.SensorRequestErrors.WithLabelValues(httpClient.sensor, endpoint).Inc()
+		if attempt == maxAttempts || !retryable(method, err) {
+			break
+		}
+		delay, ok := retryDelay(attempt, err)
+		if !ok {
+			break
+		}
+		log.Debug(module, "retrying %s %s in %v after attempt %d: %v", method, apiPath, delay, attempt, err)
+		metrics.SensorRequestRetries.WithLabelValues(httpClient.sensor, endpoint).Inc()
+		if sleep(ctx, delay) != nil {
+			break
+		}
+	}
+	httpClient.breaker.record(httpClient.sensor, err)
+	return body, err
 }
 
-func (httpClient *XovisHttp) Request(method, apiPath string, headers map[string]string) ([]byte, error) {
+func (httpClient *XovisHttp) request(ctx context.Context, method, apiPath string, headers map[string]string) ([]byte, error) {
 	url := "https://" + httpClient.host + ":" + httpClient.port + apiPath
 
 	client := &http.Client{
 		Timeout: httpClient.timeout,
 		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: !httpClient.checkCert},
+			DialContext:     httpClient.dialer.DialContext,
+			TLSClientConfig: httpClient.tlsConfig(),
 		},
 	}
 
-	req, err := http.NewRequest(method, url, nil)
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating request: %w", err)
 	}
@@ -108,12 +151,25 @@ func (httpClient *XovisHttp) Request(method, apiPath string, headers map[string]
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusCreated {
 		log.Debug(module, " -> with: %v, %v", headers, string(body))
-		return body, fmt.Errorf("%s not ok: status code: %d", url, resp.StatusCode)
+		return body, &StatusError{URL: url, Code: resp.StatusCode, RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"))}
 	}
 
 	return body, nil
 }
 
+// StatusError is returned for responses of the sensor with an unexpected status code.
+type StatusError struct {
+	URL  string
+	Code int
+
+	// Delay requested by the sensor's Retry-After header, 0 if none.
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s not ok: status code: %d", e.URL, e.Code)
+}
+
 type Geometrie struct {
 	ID   int    `json:"id"`
 	Type string `json:"type"`
@@ -134,6 +190,22 @@ type Logic struct {
 	Counts     []Count     `json:"counts"`
 }
 
+// geometries returns the geometries of the logic, without points as the
+// sensor's API does not provide them.
+func (l Logic) geometries(serialNumber string) []confmodel.Geometry {
+	geometries := make([]confmodel.Geometry, 0, len(l.Geometries))
+	for _, geometry := range l.Geometries {
+		geometries = append(geometries, confmodel.Geometry{
+			SerialNumber: serialNumber,
+			LogicID:      int32(l.ID),
+			GeometryID:   int32(geometry.ID),
+			Name:         geometry.Name,
+			Type:         geometry.Type,
+		})
+	}
+	return geometries
+}
+
 type Logics struct {
 	Time   string  `json:"time"`
 	Logics []Logic `json:"logics"`
@@ -152,32 +224,59 @@ type Xovis struct {
 	http       XovisHttp
 	login      Login
 	sensorConf confmodel.Sensor
+
+	// Negotiated on first use, see negotiateAPI.
+	api        *APIVersion
+	deviceInfo deviceInfoResponse
 }
 
 func NewXovisConnector(sensorConf confmodel.Sensor) *Xovis {
+	rootCAs, err := CertPool(sensorConf.Config.CABundle)
+	if err != nil {
+		log.Warn(module, "CA bundle of configuration %d: %v, using the system's CAs", sensorConf.Config.ID, err)
+	}
+	var pinned string
+	if sensorConf.CertFingerprint != nil {
+		pinned = *sensorConf.CertFingerprint
+	}
+	host, port := sensorConf.ConnectAddress()
+	timeout := time.Duration(sensorConf.Config.RequestTimeout) * time.Second
 	return &Xovis{
 		basicAuth: encodeBase64(sensorConf.Username + ":" + sensorConf.Password),
 		login:     Login{},
 		http: XovisHttp{
-			host:      sensorConf.Hostname,
-			port:      strconv.Itoa(int(sensorConf.Port)),
-			timeout:   time.Duration(sensorConf.Config.RequestTimeout) * time.Second,
-			checkCert: sensorConf.Config.CheckCertificate,
+			sensor:     strconv.FormatInt(sensorConf.ID, 10),
+			host:       host,
+			port:       strconv.Itoa(int(port)),
+			serverName: sensorConf.Hostname,
+			dialer:     newSensorDialer(sensorConf.Config.ProxyURL, timeout),
+			timeout:    timeout,
+			checkCert:  sensorConf.Config.CheckCertificate,
+			rootCAs:    rootCAs,
+			pinned:     pinned,
+			breaker:    breakerFor(net.JoinHostPort(host, strconv.Itoa(int(port)))),
 		},
 		sensorConf: sensorConf,
 	}
 }
 
-func (x *Xovis) DiscoverDevices() ([]confmodel.Sensor, error) {
-	deviceItself, err := x.getDeviceInfo()
+func (x *Xovis) DiscoverDevices(ctx context.Context) ([]confmodel.Sensor, error) {
+	if x.sensorConf.DiscoveryMode == "disabled" {
+		return nil, nil
+	}
+	deviceItself, err := x.getDeviceInfo(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("making request to get the device itself: %w", err)
 	}
+	if x.api.DiscoverL2 == "" {
+		log.Warn(module, "sensor %s provides the API %s without discovery, skipping discovery", x.http.host, x.api.Name)
+		return nil, nil
+	}
 
 	var resp []byte
 	switch x.sensorConf.DiscoveryMode {
 	case "L2":
-		resp, err = x.http.Request(http.MethodGet, ApiPath+"/discover/localnetwork", nil)
+		resp, err = x.http.Request(ctx, http.MethodGet, x.api.Path(x.api.DiscoverL2), nil)
 		if err != nil {
 			return nil, fmt.Errorf("making L2 request: %w", err)
 		}
@@ -189,12 +288,10 @@ func (x *Xovis) DiscoverDevices() ([]confmodel.Sensor, error) {
 			"first_ip": *x.sensorConf.L3FirstIP,
 			"count":    string(*x.sensorConf.L3Count),
 		}
-		resp, err = x.http.Request(http.MethodPost, ApiPath+"/discover/scan", body)
+		resp, err = x.http.Request(ctx, http.MethodPost, x.api.Path(x.api.DiscoverL3), body)
 		if err != nil {
 			return nil, fmt.Errorf("making L3 request: %w", err)
 		}
-	case "disabled":
-		return nil, nil
 	default:
 		return nil, fmt.Errorf("unknown discovery mode: %s", x.sensorConf.DiscoveryMode)
 	}
@@ -252,24 +349,117 @@ func (x *Xovis) DiscoverDevices() ([]confmodel.Sensor, error) {
 	return sensors, nil
 }
 
-func (x *Xovis) GetDevice() (assetmodel.PeopleCounter, error) {
-	idResp, err := x.getDeviceID()
+func (x *Xovis) GetDevice(ctx context.Context) (assetmodel.PeopleCounter, error) {
+	idResp, err := x.getDeviceID(ctx)
 	if err != nil {
-		return assetmodel.PeopleCounter{}, fmt.Errorf("getting device ID: %v", err)
+		return assetmodel.PeopleCounter{}, fmt.Errorf("getting device ID: %w", err)
 	}
 
-	deviceInfoResp, err := x.getDeviceInfo()
+	deviceInfoResp, err := x.getDeviceInfo(ctx)
 	if err != nil {
-		return assetmodel.PeopleCounter{}, fmt.Errorf("getting device info: %v", err)
+		return assetmodel.PeopleCounter{}, fmt.Errorf("getting device info: %w", err)
 	}
 
-	return assetmodel.PeopleCounter{
-		Name:   idResp.Name,
-		Group:  idResp.Group,
-		MAC:    deviceInfoResp.MAC,
-		Model:  deviceInfoResp.Type,
-		Config: &x.sensorConf.Config,
-	}, nil
+	peopleCounter := assetmodel.PeopleCounter{
+		Name:     idResp.Name,
+		Group:    idResp.Group,
+		MAC:      deviceInfoResp.MAC,
+		Serial:   deviceInfoResp.MAC,
+		Model:    deviceInfoResp.ProdCode,
+		Firmware: deviceInfoResp.FWVersion,
+		Config:   &x.sensorConf.Config,
+	}
+	if peopleCounter.Model == "" { // not reported by the API v4
+		peopleCounter.Model = deviceInfoResp.Type
+	}
+
+	x.readStates(ctx, &peopleCounter)
+	return peopleCounter, nil
+}
+
+type networkStateResponse struct {
+	Details struct {
+		IPv4 struct {
+			Address string `json:"address"`
+		} `json:"ipv4"`
+	} `json:"details"`
+}
+
+// getIPAddress returns the IPv4 address the sensor reports. If it has none,
+// the sensor's hostname is resolved.
+func (x *Xovis) getIPAddress(ctx context.Context) (string, error) {
+	if x.api.NetworkState != "" {
+		resp, err := x.request(ctx, x.api.Path(x.api.NetworkState), http.MethodGet)
+		if err != nil {
+			return "", fmt.Errorf("making request to get network state: %w", err)
+		}
+		var networkState networkStateResponse
+		if err := json.Unmarshal(resp, &networkState); err != nil {
+			return "", fmt.Errorf("parsing network state response: %w\nResponse: %s", err, string(resp))
+		}
+		if networkState.Details.IPv4.Address != "" {
+			return networkState.Details.IPv4.Address, nil
+		}
+	}
+	addrs, err := net.DefaultResolver.LookupHost(ctx, x.sensorConf.Hostname)
+	if err != nil {
+		return "", fmt.Errorf("resolving %s: %w", x.sensorConf.Hostname, err)
+	}
+	return addrs[0], nil
+}
+
+type deviceStateResponse struct {
+	Details struct {
+		UptimeSec int64 `json:"uptime_sec"`
+	} `json:"details"`
+}
+
+// getUptime returns the seconds since the last boot of the sensor.
+func (x *Xovis) getUptime(ctx context.Context) (int64, error) {
+	resp, err := x.request(ctx, x.api.Path(x.api.DeviceState), http.MethodGet)
+	if err != nil {
+		return 0, fmt.Errorf("making request to get device state: %w", err)
+	}
+	var deviceState deviceStateResponse
+	if err := json.Unmarshal(resp, &deviceState); err != nil {
+		return 0, fmt.Errorf("parsing device state response: %w\nResponse: %s", err, string(resp))
+	}
+	return deviceState.Details.UptimeSec, nil
+}
+
+type timeStateResponse struct {
+	Details struct {
+		Time string `json:"time"`
+	} `json:"details"`
+}
+
+// sensorTimeLayouts are the formats of the sensor time. Despite documented as
+// RFC 3339, sensors report the zone offset without colon.
+var sensorTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05Z0700"}
+
+// getTimeOffset returns by how many seconds the sensor's clock is ahead of
+// the app's clock. The sensor reports its time in whole seconds.
+func (x *Xovis) getTimeOffset(ctx context.Context) (int64, error) {
+	sent := time.Now()
+	resp, err := x.request(ctx, x.api.Path(x.api.TimeState), http.MethodGet)
+	if err != nil {
+		return 0, fmt.Errorf("making request to get time state: %w", err)
+	}
+	received := time.Now()
+	var timeState timeStateResponse
+	if err := json.Unmarshal(resp, &timeState); err != nil {
+		return 0, fmt.Errorf("parsing time state response: %w\nResponse: %s", err, string(resp))
+	}
+	for _, layout := range sensorTimeLayouts {
+		sensorTime, err := time.Parse(layout, timeState.Details.Time)
+		if err != nil {
+			continue
+		}
+		// Assume the sensor read its clock halfway through the request.
+		local := sent.Add(received.Sub(sent) / 2)
+		return int64(sensorTime.Sub(local).Round(time.Second) / time.Second), nil
+	}
+	return 0, fmt.Errorf("parsing sensor time %q", timeState.Details.Time)
 }
 
 type idResponse struct {
@@ -277,8 +467,12 @@ type idResponse struct {
 	Name  string `json:"name"`
 }
 
-func (x *Xovis) getDeviceID() (idResponse, error) {
-	resp, err := x.http.Request(http.MethodGet, ApiPath+"/device/id", nil)
+func (x *Xovis) getDeviceID(ctx context.Context) (idResponse, error) {
+	api, err := x.apiVersion(ctx)
+	if err != nil {
+		return idResponse{}, err
+	}
+	resp, err := x.request(ctx, api.Path(api.DeviceID), http.MethodGet)
 	if err != nil {
 		return idResponse{}, fmt.Errorf("making request to get device id: %w", err)
 	}
@@ -292,42 +486,48 @@ func (x *Xovis) getDeviceID() (idResponse, error) {
 }
 
 type deviceInfoResponse struct {
-	MAC  string `json:"serial"`
-	Type string `json:"type"`
+	MAC       string `json:"serial"`
+	Type      string `json:"type"`
+	ProdCode  string `json:"prod_code"`
+	FWVersion string `json:"fw_version"`
 }
 
-func (x *Xovis) getDeviceInfo() (deviceInfoResponse, error) {
-	resp, err := x.http.Request(http.MethodGet, ApiPath+"/device/info", nil)
-	if err != nil {
-		return deviceInfoResponse{}, fmt.Errorf("making request to get device info: %w", err)
+// getDeviceInfo returns the device info read while negotiating the API version.
+func (x *Xovis) getDeviceInfo(ctx context.Context) (deviceInfoResponse, error) {
+	if x.api != nil {
+		return x.deviceInfo, nil
 	}
-
-	var deviceInfoResp deviceInfoResponse
-	if err := json.Unmarshal(resp, &deviceInfoResp); err != nil {
-		return deviceInfoResponse{}, fmt.Errorf("parsing device info response: %w\nResponse: %s", err, string(resp))
+	api, info, err := x.negotiateAPI(ctx)
+	if err != nil {
+		return deviceInfoResponse{}, err
 	}
-
-	return deviceInfoResp, nil
+	x.api = api
+	x.deviceInfo = info
+	return info, nil
 }
 
-func (x *Xovis) ResetAllCounters() error {
-	_, err := x.request(ResetAllCountersPath, http.MethodPost)
+func (x *Xovis) ResetAllCounters(ctx context.Context) error {
+	api, err := x.apiVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("resetting all counters: %w", err)
+	}
+	_, err = x.request(ctx, api.Path(api.ResetCounts), http.MethodPost)
 	if err != nil {
 		return fmt.Errorf("resetting all counters: %w", err)
 	}
 	return nil
 }
 
-func (x *Xovis) GetAllCounters() ([]assetmodel.Line, []assetmodel.Zone, error) {
+func (x *Xovis) GetAllCounters(ctx context.Context) ([]assetmodel.Line, []assetmodel.Zone, error) {
 	var lines []assetmodel.Line
 	var zones []assetmodel.Zone
 
-	deviceInfoResp, err := x.getDeviceInfo()
+	deviceInfoResp, err := x.getDeviceInfo(ctx)
 	if err != nil {
-		return nil, nil, fmt.Errorf("getting device info: %v", err)
+		return nil, nil, fmt.Errorf("getting device info: %w", err)
 	}
 
-	logics, err := x.getCountersRaw()
+	logics, err := x.getCountersRaw(ctx)
 	if err != nil {
 		return nil, nil, fmt.Errorf("getting counter data: %w", err)
 	}
@@ -352,12 +552,13 @@ func (x *Xovis) GetAllCounters() ([]assetmodel.Line, []assetmodel.Zone, error) {
 			}
 
 			lines = append(lines, assetmodel.Line{
-				Name:      logic.Name,
-				ID:        logic.ID,
-				Forward:   lineData.ForwardTotal,
-				Backward:  lineData.BackwardTotal,
-				DeviceMac: deviceInfoResp.MAC,
-				Config:    &x.sensorConf.Config,
+				Name:       logic.Name,
+				ID:         logic.ID,
+				Forward:    lineData.ForwardTotal,
+				Backward:   lineData.BackwardTotal,
+				Geometries: logic.geometries(deviceInfoResp.MAC),
+				DeviceMac:  deviceInfoResp.MAC,
+				Config:     &x.sensorConf.Config,
 			})
 
 		case InfoTypeZone, InfoTypeZoneLegacy:
@@ -366,11 +567,12 @@ func (x *Xovis) GetAllCounters() ([]assetmodel.Line, []assetmodel.Zone, error) {
 				continue
 			}
 			zones = append(zones, assetmodel.Zone{
-				Name:      logic.Name,
-				ID:        logic.ID,
-				Presence:  logic.Counts[0].Value,
-				DeviceMac: deviceInfoResp.MAC,
-				Config:    &x.sensorConf.Config,
+				Name:       logic.Name,
+				ID:         logic.ID,
+				Presence:   logic.Counts[0].Value,
+				Geometries: logic.geometries(deviceInfoResp.MAC),
+				DeviceMac:  deviceInfoResp.MAC,
+				Config:     &x.sensorConf.Config,
 			})
 
 		default:
@@ -381,9 +583,13 @@ func (x *Xovis) GetAllCounters() ([]assetmodel.Line, []assetmodel.Zone, error) {
 	return lines, zones, nil
 }
 
-func (x *Xovis) getCountersRaw() (Logics, error) {
+func (x *Xovis) getCountersRaw(ctx context.Context) (Logics, error) {
 	var logics Logics
-	rawData, err := x.request(AllCountersPath, http.MethodGet)
+	api, err := x.apiVersion(ctx)
+	if err != nil {
+		return logics, err
+	}
+	rawData, err := x.request(ctx, api.Path(api.Logics), http.MethodGet)
 	if err != nil {
 		return logics, fmt.Errorf("getting counter data: %w", err)
 	}
@@ -393,12 +599,12 @@ func (x *Xovis) getCountersRaw() (Logics, error) {
 	return logics, nil
 }
 
-func (x *Xovis) request(path, method string) ([]byte, error) {
+func (x *Xovis) request(ctx context.Context, path, method string) ([]byte, error) {
 	headers := map[string]string{
 		"Authorization": "Basic " + x.basicAuth,
 		"Accept":        "application/json",
 	}
-	jsonBody, err := x.http.Request(method, path, headers)
+	jsonBody, err := x.http.Request(ctx, method, path, headers)
 	if err != nil {
 		x.login.LastUsedAt = 0
 		x.login.ReceivedAt = 0
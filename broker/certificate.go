@@ -0,0 +1,155 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package broker
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// CertificateMismatchError is returned if the sensor presents another
+// certificate than the pinned one. This happens after replacing the sensor or
+// its certificate, but might also be an attack.
+type CertificateMismatchError struct {
+	Pinned    string
+	Presented string
+}
+
+func (e *CertificateMismatchError) Error() string {
+	return fmt.Sprintf("certificate fingerprint %s does not match the pinned fingerprint %s", e.Presented, e.Pinned)
+}
+
+// Fingerprint returns the SHA-256 fingerprint of the certificate as colon
+// separated hex, as shown by browsers.
+func Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return formatFingerprint(sum[:])
+}
+
+func formatFingerprint(sum []byte) string {
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(parts, ":")
+}
+
+// NormalizeFingerprint parses a SHA-256 fingerprint given as hex, with or
+// without colons, and returns it in the format of Fingerprint.
+func NormalizeFingerprint(fingerprint string) (string, error) {
+	sum, err := hex.DecodeString(strings.NewReplacer(":", "", " ", "").Replace(fingerprint))
+	if err != nil || len(sum) != sha256.Size {
+		return "", fmt.Errorf("not a SHA-256 fingerprint: %q", fingerprint)
+	}
+	return formatFingerprint(sum), nil
+}
+
+// CertPool returns the system's CAs extended by the PEM encoded bundle, nil
+// for the system's CAs only.
+func CertPool(bundle *string) (*x509.CertPool, error) {
+	if bundle == nil || *bundle == "" {
+		return nil, nil
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM([]byte(*bundle)) {
+		return nil, errors.New("no certificate found in the CA bundle")
+	}
+	return pool, nil
+}
+
+// ValidateCABundle checks that the PEM encoded bundle contains certificates.
+func ValidateCABundle(bundle string) error {
+	_, err := CertPool(&bundle)
+	return err
+}
+
+// tlsConfig verifies the sensor's certificate against the CAs if certificates
+// are checked. Otherwise, the chain is not verified but the certificate must
+// match the pinned fingerprint, if one is pinned.
+func (httpClient *XovisHttp) tlsConfig() *tls.Config {
+	return &tls.Config{
+		ServerName:         httpClient.serverName,
+		RootCAs:            httpClient.rootCAs,
+		InsecureSkipVerify: !httpClient.checkCert,
+		VerifyConnection:   httpClient.verifyPin,
+	}
+}
+
+func (httpClient *XovisHttp) verifyPin(state tls.ConnectionState) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("the sensor presented no certificate")
+	}
+	httpClient.fingerprint = Fingerprint(state.PeerCertificates[0])
+	if httpClient.checkCert || httpClient.pinned == "" || httpClient.fingerprint == httpClient.pinned {
+		return nil
+	}
+	return &CertificateMismatchError{Pinned: httpClient.pinned, Presented: httpClient.fingerprint}
+}
+
+// dialTLS connects to the sensor and completes the TLS handshake.
+func (httpClient *XovisHttp) dialTLS(ctx context.Context, config *tls.Config) (*tls.Conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, httpClient.timeout)
+	defer cancel()
+	conn, err := httpClient.dialer.DialContext(ctx, "tcp", httpClient.address())
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s%s: %v", httpClient.address(), httpClient.dialer.via(), err)
+	}
+	tlsConn := tls.Client(conn, config)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("TLS handshake: %w", err)
+	}
+	return tlsConn, nil
+}
+
+func (httpClient *XovisHttp) address() string {
+	return net.JoinHostPort(httpClient.host, httpClient.port)
+}
+
+// FingerprintToPin returns the fingerprint of the certificate presented by
+// the sensor if it is to be pinned: certificates are not checked and none is
+// pinned yet. It is empty before the first request.
+func (x *Xovis) FingerprintToPin() string {
+	if x.http.checkCert || x.http.pinned != "" {
+		return ""
+	}
+	return x.http.fingerprint
+}
+
+// CertificateFingerprint connects to the sensor and returns the fingerprint
+// of the certificate it presents, regardless of the pinned one.
+func (x *Xovis) CertificateFingerprint(ctx context.Context) (string, error) {
+	conn, err := x.http.dialTLS(ctx, &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return "", errors.New("the sensor presented no certificate")
+	}
+	return Fingerprint(certs[0]), nil
+}
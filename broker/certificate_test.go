@@ -0,0 +1,148 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package broker
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	confmodel "xovis/model/conf"
+)
+
+// startTLSSensor starts a sensor answering every request, with the
+// certificate of httptest issued for 127.0.0.1.
+func startTLSSensor(t *testing.T) (*httptest.Server, confmodel.Sensor) {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sensor := confmodel.Sensor{Hostname: host, Port: int32(portNumber)}
+	sensor.Config.RequestTimeout = 5
+	return server, sensor
+}
+
+func certificatePEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+func TestCertificatePinning(t *testing.T) {
+	server, sensor := startTLSSensor(t)
+	presented := Fingerprint(server.Certificate())
+	other := "00:11:22:33:44:55:66:77:88:99:AA:BB:CC:DD:EE:FF:00:11:22:33:44:55:66:77:88:99:AA:BB:CC:DD:EE:FF"
+	bundle := certificatePEM(server.Certificate())
+
+	tests := []struct {
+		name         string
+		pinned       string
+		checkCert    bool
+		caBundle     *string
+		wantMismatch bool
+		wantErr      bool
+		wantPin      string // fingerprint to pin after the request
+	}{
+		{name: "pin matches", pinned: presented},
+		{name: "pin does not match", pinned: other, wantMismatch: true},
+		{name: "trust on first use", wantPin: presented},
+		{name: "verified by the CA bundle", checkCert: true, caBundle: &bundle},
+		{name: "verified by the CA bundle, pin ignored", pinned: other, checkCert: true, caBundle: &bundle},
+		{name: "not verified without the CA bundle", checkCert: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sensor := sensor
+			sensor.Config.CheckCertificate = tt.checkCert
+			sensor.Config.CABundle = tt.caBundle
+			if tt.pinned != "" {
+				sensor.CertFingerprint = &tt.pinned
+			}
+			x := NewXovisConnector(sensor)
+			_, err := x.http.Request(context.Background(), http.MethodGet, "/api/v5/device/info", nil)
+
+			var mismatchErr *CertificateMismatchError
+			switch {
+			case tt.wantMismatch:
+				if !errors.As(err, &mismatchErr) {
+					t.Fatalf("request error %v, want a certificate mismatch", err)
+				}
+				if mismatchErr.Presented != presented || mismatchErr.Pinned != tt.pinned {
+					t.Errorf("mismatch of %s with %s, want %s with %s", mismatchErr.Presented, mismatchErr.Pinned, presented, tt.pinned)
+				}
+			case tt.wantErr:
+				if err == nil {
+					t.Fatal("request succeeded, want an error")
+				}
+			case err != nil:
+				t.Fatalf("request: %v", err)
+			}
+			if got := x.FingerprintToPin(); got != tt.wantPin {
+				t.Errorf("fingerprint to pin %q, want %q", got, tt.wantPin)
+			}
+		})
+	}
+}
+
+func TestCertPool(t *testing.T) {
+	server, _ := startTLSSensor(t)
+	valid := certificatePEM(server.Certificate())
+	empty := ""
+	invalid := "-----BEGIN CERTIFICATE-----\nbm90IGEgY2VydGlmaWNhdGU=\n-----END CERTIFICATE-----\n"
+	text := "not a certificate"
+
+	tests := []struct {
+		name     string
+		bundle   *string
+		wantErr  bool
+		wantPool bool
+	}{
+		{name: "none", bundle: nil},
+		{name: "empty", bundle: &empty},
+		{name: "valid", bundle: &valid, wantPool: true},
+		{name: "invalid certificate", bundle: &invalid, wantErr: true},
+		{name: "no PEM", bundle: &text, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool, err := CertPool(tt.bundle)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CertPool() error %v, want error %v", err, tt.wantErr)
+			}
+			if (pool != nil) != tt.wantPool {
+				t.Fatalf("CertPool() pool %v, want pool %v", pool != nil, tt.wantPool)
+			}
+			if pool == nil {
+				return
+			}
+			if _, err := server.Certificate().Verify(x509.VerifyOptions{Roots: pool, DNSName: "127.0.0.1"}); err != nil {
+				t.Errorf("certificate of the bundle not trusted: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package broker
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Names of the diagnostic checks in the order they are run.
+const (
+	CheckReachability = "reachability"
+	CheckTLS          = "tls"
+	CheckFirmware     = "firmware"
+	CheckCredentials  = "credentials"
+	CheckLogics       = "logics"
+)
+
+const (
+	CheckStatusOK      = "ok"
+	CheckStatusFailing = "failing"
+	CheckStatusSkipped = "skipped"
+)
+
+type CheckResult struct {
+	Name    string
+	Status  string
+	Message string
+}
+
+type DeviceInfo struct {
+	MAC       string
+	Model     string
+	FWVersion string
+	Name      string
+	Group     string
+}
+
+// Diagnosis is the result of testing the connection to a sensor.
+type Diagnosis struct {
+	Checks []CheckResult
+	Device *DeviceInfo // nil if the device info could not be read
+
+	Fingerprint string // of the sensor's certificate, empty if the TLS handshake failed
+	Pin         string // fingerprint to pin, see FingerprintToPin
+}
+
+// OK reports whether all checks passed.
+func (d Diagnosis) OK() bool {
+	for _, check := range d.Checks {
+		if check.Status != CheckStatusOK {
+			return false
+		}
+	}
+	return true
+}
+
+// Diagnose tests the connection to the sensor step by step, from reaching its
+// port up to reading the logics. After the first failing check, the remaining
+// checks are skipped. Nothing is changed on the sensor.
+func (x *Xovis) Diagnose(ctx context.Context) Diagnosis {
+	// Testing contacts the sensor even if collecting skips it.
+	x.http.breaker = nil
+
+	var diagnosis Diagnosis
+	device := DeviceInfo{}
+	checks := []struct {
+		name string
+		run  func(ctx context.Context) (string, error)
+	}{
+		{CheckReachability, x.checkReachability},
+		{CheckTLS, x.checkTLS},
+		{CheckFirmware, func(ctx context.Context) (string, error) { return x.checkFirmware(ctx, &device) }},
+		{CheckCredentials, func(ctx context.Context) (string, error) { return x.checkCredentials(ctx, &device) }},
+		{CheckLogics, x.checkLogics},
+	}
+
+	failed := false
+	for _, check := range checks {
+		if failed {
+			diagnosis.Checks = append(diagnosis.Checks, CheckResult{Name: check.name, Status: CheckStatusSkipped})
+			continue
+		}
+		message, err := check.run(ctx)
+		if err != nil {
+			failed = true
+			diagnosis.Checks = append(diagnosis.Checks, CheckResult{Name: check.name, Status: CheckStatusFailing, Message: err.Error()})
+			continue
+		}
+		diagnosis.Checks = append(diagnosis.Checks, CheckResult{Name: check.name, Status: CheckStatusOK, Message: message})
+	}
+
+	if device.MAC != "" {
+		diagnosis.Device = &device
+	}
+	diagnosis.Fingerprint = x.http.fingerprint
+	diagnosis.Pin = x.FingerprintToPin()
+	return diagnosis
+}
+
+func (x *Xovis) checkReachability(ctx context.Context) (string, error) {
+	start := time.Now()
+	conn, err := x.http.dialer.DialContext(ctx, "tcp", x.http.address())
+	if err != nil {
+		return "", fmt.Errorf("connecting to %s%s: %v", x.http.address(), x.http.dialer.via(), err)
+	}
+	conn.Close()
+	return fmt.Sprintf("connected to %s%s in %v", x.http.address(), x.http.dialer.via(), time.Since(start).Round(time.Millisecond)), nil
+}
+
+func (x *Xovis) checkTLS(ctx context.Context) (string, error) {
+	conn, err := x.http.dialTLS(ctx, x.http.tlsConfig())
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	state := conn.ConnectionState()
+	message := tls.VersionName(state.Version)
+	if len(state.PeerCertificates) > 0 {
+		cert := state.PeerCertificates[0]
+		message += fmt.Sprintf(", certificate %q valid until %s", cert.Subject.CommonName, cert.NotAfter.Format(time.DateOnly))
+	}
+	message += ", fingerprint " + x.http.fingerprint
+	switch {
+	case x.http.checkCert:
+	case x.http.pinned != "":
+		message += ", certificate not verified but matches the pinned fingerprint"
+	default:
+		message += ", certificate not verified and not pinned yet"
+	}
+	return message, nil
+}
+
+func (x *Xovis) checkFirmware(ctx context.Context, device *DeviceInfo) (string, error) {
+	info, err := x.getDeviceInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	device.MAC = info.MAC
+	device.Model = info.Type
+	device.FWVersion = info.FWVersion
+	return fmt.Sprintf("%s with firmware %s, using the API %s", info.Type, info.FWVersion, x.api.Name), nil
+}
+
+func (x *Xovis) checkCredentials(ctx context.Context, device *DeviceInfo) (string, error) {
+	id, err := x.getDeviceID(ctx)
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && (statusErr.Code == http.StatusUnauthorized || statusErr.Code == http.StatusForbidden) {
+		return "", fmt.Errorf("credentials of user %q rejected by the sensor (status code %d)", x.sensorConf.Username, statusErr.Code)
+	}
+	if err != nil {
+		return "", err
+	}
+	device.Name = id.Name
+	device.Group = id.Group
+	return fmt.Sprintf("logged in as %q", x.sensorConf.Username), nil
+}
+
+func (x *Xovis) checkLogics(ctx context.Context) (string, error) {
+	logics, err := x.getCountersRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+	supported := 0
+	for _, logic := range logics.Logics {
+		switch logic.Info {
+		case InfoTypeLine, InfoTypeLineLegacy, InfoTypeZone, InfoTypeZoneLegacy:
+			supported++
+		}
+	}
+	return fmt.Sprintf("%d logics readable, %d of them supported line or zone counts", len(logics.Logics), supported), nil
+}
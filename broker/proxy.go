@@ -0,0 +1,161 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package broker
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+var defaultProxyPorts = map[string]string{
+	"http":    "80",
+	"https":   "443",
+	"socks5":  "1080",
+	"socks5h": "1080",
+}
+
+// ParseProxyURL parses the proxy of a configuration. Supported are HTTP
+// proxies (http, https) tunneling with CONNECT and SOCKS5 proxies (socks5,
+// socks5h), with optional credentials in the URL.
+func ParseProxyURL(rawURL string) (*url.URL, error) {
+	proxyURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := defaultProxyPorts[proxyURL.Scheme]; !ok {
+		return nil, fmt.Errorf("unsupported proxy scheme %q, use http, https, socks5 or socks5h", proxyURL.Scheme)
+	}
+	if proxyURL.Hostname() == "" {
+		return nil, fmt.Errorf("proxy host missing in %q", rawURL)
+	}
+	if proxyURL.Port() == "" {
+		proxyURL.Host = net.JoinHostPort(proxyURL.Hostname(), defaultProxyPorts[proxyURL.Scheme])
+	}
+	return proxyURL, nil
+}
+
+// sensorDialer connects to sensors, directly or through a proxy.
+type sensorDialer struct {
+	timeout time.Duration
+	proxy   *url.URL // nil to connect directly
+	err     error    // invalid proxy, returned for every connection
+}
+
+func newSensorDialer(proxyURL *string, timeout time.Duration) sensorDialer {
+	dialer := sensorDialer{timeout: timeout}
+	if proxyURL != nil && *proxyURL != "" {
+		dialer.proxy, dialer.err = ParseProxyURL(*proxyURL)
+	}
+	return dialer
+}
+
+func (d sensorDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if d.err != nil {
+		return nil, fmt.Errorf("invalid proxy: %v", d.err)
+	}
+	direct := &net.Dialer{Timeout: d.timeout}
+	if d.proxy == nil {
+		return direct.DialContext(ctx, network, address)
+	}
+	switch d.proxy.Scheme {
+	case "socks5", "socks5h":
+		socks, err := proxy.FromURL(d.proxy, direct)
+		if err != nil {
+			return nil, fmt.Errorf("creating SOCKS5 dialer: %v", err)
+		}
+		conn, err := socks.(proxy.ContextDialer).DialContext(ctx, network, address)
+		if err != nil {
+			return nil, fmt.Errorf("connecting through proxy %s: %w", d.proxy.Redacted(), err)
+		}
+		return conn, nil
+	default:
+		return d.dialConnect(ctx, direct, address)
+	}
+}
+
+// dialConnect opens a tunnel to the address through an HTTP proxy.
+func (d sensorDialer) dialConnect(ctx context.Context, direct *net.Dialer, address string) (net.Conn, error) {
+	conn, err := direct.DialContext(ctx, "tcp", d.proxy.Host)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to proxy %s: %w", d.proxy.Redacted(), err)
+	}
+	if d.proxy.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: d.proxy.Hostname()})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("TLS handshake with proxy %s: %w", d.proxy.Redacted(), err)
+		}
+		conn = tlsConn
+	}
+
+	deadline := time.Now().Add(d.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: make(http.Header),
+	}
+	if user := d.proxy.User; user != nil {
+		password, _ := user.Password()
+		req.Header.Set("Proxy-Authorization", "Basic "+encodeBase64(user.Username()+":"+password))
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("sending CONNECT to proxy %s: %w", d.proxy.Redacted(), err)
+	}
+	// The sensor does not send anything before the TLS handshake, so nothing
+	// but the response is buffered.
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("reading CONNECT response of proxy %s: %w", d.proxy.Redacted(), err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s refused to connect to %s: %s", d.proxy.Redacted(), address, resp.Status)
+	}
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// via describes the way to the sensor for messages.
+func (d sensorDialer) via() string {
+	if d.proxy == nil {
+		return ""
+	}
+	return " through proxy " + d.proxy.Redacted()
+}
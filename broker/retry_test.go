@@ -0,0 +1,150 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRetryable(t *testing.T) {
+	timeout := &url.Error{Op: "Get", URL: "https://sensor/api/v5/info", Err: context.DeadlineExceeded}
+	reset := &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+	status := func(code int) error {
+		return fmt.Errorf("getting info: %w", &StatusError{URL: "https://sensor/api/v5/info", Code: code})
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		err    error
+		want   bool
+	}{
+		{name: "too many requests", method: http.MethodGet, err: status(http.StatusTooManyRequests), want: true},
+		{name: "too many requests, POST", method: http.MethodPost, err: status(http.StatusTooManyRequests), want: true},
+		{name: "service unavailable, PUT", method: http.MethodPut, err: status(http.StatusServiceUnavailable), want: true},
+		{name: "bad gateway", method: http.MethodGet, err: status(http.StatusBadGateway), want: true},
+		{name: "bad gateway, POST", method: http.MethodPost, err: status(http.StatusBadGateway), want: false},
+		{name: "gateway timeout", method: http.MethodGet, err: status(http.StatusGatewayTimeout), want: true},
+		{name: "internal server error", method: http.MethodGet, err: status(http.StatusInternalServerError), want: false},
+		{name: "unauthorized", method: http.MethodGet, err: status(http.StatusUnauthorized), want: false},
+		{name: "timeout", method: http.MethodGet, err: timeout, want: true},
+		{name: "timeout, POST", method: http.MethodPost, err: timeout, want: false},
+		{name: "connection reset", method: http.MethodGet, err: reset, want: true},
+		{name: "connection reset, PUT", method: http.MethodPut, err: reset, want: false},
+		{name: "unexpected EOF", method: http.MethodGet, err: fmt.Errorf("reading body: %w", io.ErrUnexpectedEOF), want: true},
+		{name: "EOF", method: http.MethodGet, err: io.EOF, want: true},
+		{name: "connection refused", method: http.MethodGet, err: refused, want: false},
+		{name: "other error", method: http.MethodGet, err: errors.New("invalid response"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryable(tt.method, tt.err); got != tt.want {
+				t.Errorf("retryable(%s, %v) = %v, want %v", tt.method, tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempt  int
+		err      error
+		min, max time.Duration
+		wantOK   bool
+	}{
+		{name: "first attempt", attempt: 1, err: io.EOF, min: 250 * time.Millisecond, max: 500 * time.Millisecond, wantOK: true},
+		{name: "second attempt", attempt: 2, err: io.EOF, min: 500 * time.Millisecond, max: time.Second, wantOK: true},
+		{name: "third attempt", attempt: 3, err: io.EOF, min: time.Second, max: 2 * time.Second, wantOK: true},
+		{
+			name:    "retry after",
+			attempt: 1,
+			err:     &StatusError{Code: http.StatusServiceUnavailable, RetryAfter: 10 * time.Second},
+			min:     10 * time.Second,
+			max:     10 * time.Second,
+			wantOK:  true,
+		},
+		{
+			name:    "retry after at the limit",
+			attempt: 1,
+			err:     &StatusError{Code: http.StatusTooManyRequests, RetryAfter: maxRetryAfter},
+			min:     maxRetryAfter,
+			max:     maxRetryAfter,
+			wantOK:  true,
+		},
+		{
+			name:    "retry after too long",
+			attempt: 1,
+			err:     &StatusError{Code: http.StatusTooManyRequests, RetryAfter: time.Minute},
+			min:     time.Minute,
+			max:     time.Minute,
+			wantOK:  false,
+		},
+		{
+			name:    "status without retry after",
+			attempt: 2,
+			err:     &StatusError{Code: http.StatusServiceUnavailable},
+			min:     500 * time.Millisecond,
+			max:     time.Second,
+			wantOK:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The delay is jittered, so it is checked repeatedly.
+			for range 100 {
+				delay, ok := retryDelay(tt.attempt, tt.err)
+				if ok != tt.wantOK || delay < tt.min || delay > tt.max {
+					t.Fatalf("retryDelay(%d, %v) = %v, %v, want %v to %v, %v", tt.attempt, tt.err, delay, ok, tt.min, tt.max, tt.wantOK)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		min, max time.Duration
+	}{
+		{name: "missing", header: ""},
+		{name: "seconds", header: "5", min: 5 * time.Second, max: 5 * time.Second},
+		{name: "zero seconds", header: "0"},
+		{name: "negative seconds", header: "-3"},
+		{name: "invalid", header: "soon"},
+		{name: "date", header: time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), min: 58 * time.Second, max: time.Minute},
+		{name: "past date", header: time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.header); got < tt.min || got > tt.max {
+				t.Errorf("parseRetryAfter(%q) = %v, want %v to %v", tt.header, got, tt.min, tt.max)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package broker
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+	assetmodel "xovis/model/asset"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// The IP address, boot time and clock offset of a sensor change rarely, so
+// they are read at most every stateReadInterval rather than each collection.
+const stateReadInterval = 15 * time.Minute
+
+// sensorState holds the states last read from a sensor. The uptime is counted
+// on from the boot time until the next read, so a reboot shows after at most
+// stateReadInterval.
+type sensorState struct {
+	mu         sync.Mutex
+	readAt     time.Time
+	ip         string
+	bootTime   time.Time // zero if unknown
+	timeOffset *int64
+
+	// States the sensor's user lacks the privileges for, which is logged
+	// only once.
+	denied map[string]bool
+}
+
+var (
+	statesMu sync.Mutex
+	states   = make(map[string]*sensorState)
+)
+
+// stateFor returns the states of the sensor at the address as read with the
+// user. Connectors are created for each cycle, so the states outlive them.
+func stateFor(username, address string) *sensorState {
+	statesMu.Lock()
+	defer statesMu.Unlock()
+	key := username + "@" + address
+	state, ok := states[key]
+	if !ok {
+		state = &sensorState{denied: make(map[string]bool)}
+		states[key] = state
+	}
+	return state
+}
+
+// readStates sets the IP address, uptime and time offset of the people
+// counter, read from the sensor if they are older than stateReadInterval.
+// They need privileges the sensor's user might not have, and sensors
+// providing an older API version lack some of them. They are left empty if
+// they were never read, and keep their last value if a read fails.
+func (x *Xovis) readStates(ctx context.Context, peopleCounter *assetmodel.PeopleCounter) {
+	state := stateFor(x.sensorConf.Username, net.JoinHostPort(x.http.host, x.http.port))
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	now := time.Now()
+	if state.readAt.IsZero() || now.Sub(state.readAt) >= stateReadInterval {
+		state.readAt = now
+		if ip, err := x.getIPAddress(ctx); err != nil {
+			x.warnState(state, "IP address", err)
+		} else {
+			state.ip = ip
+			delete(state.denied, "IP address")
+		}
+		if x.api.DeviceState != "" {
+			if uptime, err := x.getUptime(ctx); err != nil {
+				x.warnState(state, "uptime", err)
+			} else {
+				state.bootTime = now.Add(-time.Duration(uptime) * time.Second)
+				delete(state.denied, "uptime")
+			}
+		}
+		if x.api.TimeState != "" {
+			if offset, err := x.getTimeOffset(ctx); err != nil {
+				x.warnState(state, "time offset", err)
+			} else {
+				state.timeOffset = &offset
+				delete(state.denied, "time offset")
+			}
+		}
+	}
+
+	peopleCounter.IP = state.ip
+	if !state.bootTime.IsZero() {
+		uptime := int64(now.Sub(state.bootTime) / time.Second)
+		peopleCounter.Uptime = &uptime
+	}
+	if state.timeOffset != nil {
+		offset := *state.timeOffset
+		peopleCounter.TimeOffset = &offset
+	}
+}
+
+// warnState logs that a state could not be read. A missing privilege fails
+// every read until it is granted on the sensor, so it is only logged once.
+func (x *Xovis) warnState(state *sensorState, name string, err error) {
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.Code != http.StatusUnauthorized && statusErr.Code != http.StatusForbidden {
+		log.Warn(module, "getting %s of %s: %v", name, x.http.host, err)
+		return
+	}
+	if state.denied[name] {
+		log.Debug(module, "getting %s of %s: %v", name, x.http.host, err)
+		return
+	}
+	state.denied[name] = true
+	log.Warn(module, "user %q of %s lacks the privilege to read the %s, leaving it empty: %v", x.sensorConf.Username, x.http.host, name, err)
+}
@@ -0,0 +1,79 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package broker
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	assetmodel "xovis/model/asset"
+	confmodel "xovis/model/conf"
+)
+
+func TestReadStatesCached(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/api/v5/network/state":
+			_, _ = w.Write([]byte(`{"details": {"ipv4": {"address": "10.0.0.12"}}}`))
+		case "/api/v5/device/state":
+			_, _ = w.Write([]byte(`{"details": {"uptime_sec": 3600}}`))
+		default:
+			http.Error(w, "forbidden", http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sensor := confmodel.Sensor{Hostname: host, Port: int32(portNumber), Username: "viewer"}
+	sensor.Config.RequestTimeout = 5
+
+	// Connectors are created for each cycle.
+	for cycle := range 3 {
+		x := NewXovisConnector(sensor)
+		x.api = &APIv5
+		var peopleCounter assetmodel.PeopleCounter
+		x.readStates(context.Background(), &peopleCounter)
+		if peopleCounter.IP != "10.0.0.12" {
+			t.Errorf("cycle %d: IP %q, want 10.0.0.12", cycle, peopleCounter.IP)
+		}
+		if peopleCounter.Uptime == nil || *peopleCounter.Uptime < 3600 {
+			t.Errorf("cycle %d: uptime %v, want at least 3600", cycle, peopleCounter.Uptime)
+		}
+		if peopleCounter.TimeOffset != nil {
+			t.Errorf("cycle %d: time offset %d without privilege", cycle, *peopleCounter.TimeOffset)
+		}
+	}
+	for _, path := range []string{"/api/v5/network/state", "/api/v5/device/state", "/api/v5/time/state"} {
+		if requests[path] != 1 {
+			t.Errorf("%s requested %d times, want once", path, requests[path])
+		}
+	}
+}
@@ -18,8 +18,12 @@ package conf
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 	"xovis/appdb"
 	confmodel "xovis/model/conf"
 
@@ -28,11 +32,42 @@ import (
 	"github.com/eliona-smart-building-assistant/go-utils/log"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
 )
 
 var ErrBadRequest = errors.New("bad request")
 var ErrNotFound = errors.New("not found")
 
+// changes carries IDs of configurations whose settings or sensors were modified
+// through the app, so that running collectors can react without waiting.
+var changes = make(chan int64, 100)
+
+// Changes returns a channel with IDs of changed configurations.
+func Changes() <-chan int64 {
+	return changes
+}
+
+func notifyChange(configID int64) {
+	select {
+	case changes <- configID:
+	default:
+		log.Debug("conf", "change queue full, config %d will be picked up by the next reconciliation", configID)
+	}
+}
+
+// Ping checks that the database used by the app is reachable.
+func Ping(ctx context.Context) error {
+	db, ok := boil.GetContextDB().(interface {
+		PingContext(ctx context.Context) error
+	})
+	if !ok {
+		return fmt.Errorf("database handle does not support ping")
+	}
+	return db.PingContext(ctx)
+}
+
 func InsertConfig(ctx context.Context, config confmodel.Configuration) (confmodel.Configuration, error) {
 	dbConfig, err := toDbConfig(ctx, config)
 	if err != nil {
@@ -41,6 +76,7 @@ func InsertConfig(ctx context.Context, config confmodel.Configuration) (confmode
 	if err := dbConfig.InsertG(ctx, boil.Infer()); err != nil {
 		return confmodel.Configuration{}, fmt.Errorf("inserting DB config: %v", err)
 	}
+	notifyChange(dbConfig.ID)
 	return config, nil
 }
 
@@ -52,6 +88,7 @@ func UpsertConfig(ctx context.Context, config confmodel.Configuration) (confmode
 	if err := dbConfig.UpsertG(ctx, true, []string{"id"}, boil.Blacklist("id"), boil.Infer()); err != nil {
 		return confmodel.Configuration{}, fmt.Errorf("upserting DB config: %v", err)
 	}
+	notifyChange(dbConfig.ID)
 	return config, nil
 }
 
@@ -92,6 +129,7 @@ func DeleteConfig(ctx context.Context, configID int64) error {
 	if count == 0 {
 		return ErrNotFound
 	}
+	notifyChange(configID)
 	return nil
 }
 
@@ -113,14 +151,36 @@ func GetConfigs(ctx context.Context) ([]confmodel.Configuration, error) {
 
 func toDbConfig(ctx context.Context, appConfig confmodel.Configuration) (appdb.Configuration, error) {
 	dbConfig := appdb.Configuration{
-		ID:               appConfig.ID,
-		CheckCertificate: appConfig.CheckCertificate,
-		RefreshInterval:  appConfig.RefreshInterval,
-		RequestTimeout:   appConfig.RequestTimeout,
-		Active:           appConfig.Active,
-		Enable:           appConfig.Enable,
-		ProjectIds:       appConfig.ProjectIDs,
-		UserID:           appConfig.UserId,
+		ID:                    appConfig.ID,
+		CheckCertificate:      appConfig.CheckCertificate,
+		RefreshInterval:       appConfig.RefreshInterval,
+		RequestTimeout:        appConfig.RequestTimeout,
+		Active:                appConfig.Active,
+		Enable:                appConfig.Enable,
+		ProjectIds:            appConfig.ProjectIDs,
+		UserID:                appConfig.UserId,
+		HierarchyMode:         appConfig.HierarchyMode,
+		CaBundle:              null.StringFromPtr(appConfig.CABundle),
+		ProxyURL:              null.StringFromPtr(appConfig.ProxyURL),
+		MQTTBrokerURL:         null.StringFromPtr(appConfig.MQTTBrokerURL),
+		MQTTTopics:            appConfig.MQTTTopics,
+		MQTTUsername:          null.StringFromPtr(appConfig.MQTTUsername),
+		MQTTPassword:          null.StringFromPtr(appConfig.MQTTPassword),
+		PreferLogicsPush:      appConfig.PreferLogicsPush,
+		HealthAlarms:          appConfig.HealthAlarms,
+		TrackingEnabled:       appConfig.TrackingEnabled,
+		HeatmapCellSize:       appConfig.HeatmapCellSize,
+		HeatmapWindow:         appConfig.HeatmapWindow,
+		ArchiveEnabled:        appConfig.ArchiveEnabled,
+		ArchiveMaxAge:         appConfig.ArchiveMaxAge,
+		ArchiveMaxSize:        appConfig.ArchiveMaxSize,
+		ClassificationEnabled: appConfig.ClassificationEnabled,
+		ChildMaxHeight:        appConfig.ChildMaxHeight,
+		GroupMinMembers:       appConfig.GroupMinMembers,
+		StaffTag:              appConfig.StaffTag,
+	}
+	if dbConfig.MQTTTopics == nil {
+		dbConfig.MQTTTopics = []string{}
 	}
 
 	env := frontend.GetEnvironment(ctx)
@@ -133,14 +193,43 @@ func toDbConfig(ctx context.Context, appConfig confmodel.Configuration) (appdb.C
 
 func toAppConfig(dbConfig *appdb.Configuration) (confmodel.Configuration, error) {
 	appConfig := confmodel.Configuration{
-		ID:               dbConfig.ID,
-		CheckCertificate: dbConfig.CheckCertificate,
-		RefreshInterval:  dbConfig.RefreshInterval,
-		RequestTimeout:   dbConfig.RequestTimeout,
-		Active:           dbConfig.Active,
-		Enable:           dbConfig.Enable,
-		ProjectIDs:       dbConfig.ProjectIds,
-		UserId:           dbConfig.UserID,
+		ID:                    dbConfig.ID,
+		CheckCertificate:      dbConfig.CheckCertificate,
+		RefreshInterval:       dbConfig.RefreshInterval,
+		RequestTimeout:        dbConfig.RequestTimeout,
+		Active:                dbConfig.Active,
+		Enable:                dbConfig.Enable,
+		ProjectIDs:            dbConfig.ProjectIds,
+		UserId:                dbConfig.UserID,
+		HierarchyMode:         dbConfig.HierarchyMode,
+		MQTTTopics:            dbConfig.MQTTTopics,
+		PreferLogicsPush:      dbConfig.PreferLogicsPush,
+		HealthAlarms:          dbConfig.HealthAlarms,
+		TrackingEnabled:       dbConfig.TrackingEnabled,
+		HeatmapCellSize:       dbConfig.HeatmapCellSize,
+		HeatmapWindow:         dbConfig.HeatmapWindow,
+		ArchiveEnabled:        dbConfig.ArchiveEnabled,
+		ArchiveMaxAge:         dbConfig.ArchiveMaxAge,
+		ArchiveMaxSize:        dbConfig.ArchiveMaxSize,
+		ClassificationEnabled: dbConfig.ClassificationEnabled,
+		ChildMaxHeight:        dbConfig.ChildMaxHeight,
+		GroupMinMembers:       dbConfig.GroupMinMembers,
+		StaffTag:              dbConfig.StaffTag,
+	}
+	if dbConfig.CaBundle.Valid {
+		appConfig.CABundle = &dbConfig.CaBundle.String
+	}
+	if dbConfig.ProxyURL.Valid {
+		appConfig.ProxyURL = &dbConfig.ProxyURL.String
+	}
+	if dbConfig.MQTTBrokerURL.Valid {
+		appConfig.MQTTBrokerURL = &dbConfig.MQTTBrokerURL.String
+	}
+	if dbConfig.MQTTUsername.Valid {
+		appConfig.MQTTUsername = &dbConfig.MQTTUsername.String
+	}
+	if dbConfig.MQTTPassword.Valid {
+		appConfig.MQTTPassword = &dbConfig.MQTTPassword.String
 	}
 	return appConfig, nil
 }
@@ -153,7 +242,39 @@ func InsertSensor(ctx context.Context, sensor confmodel.Sensor) (confmodel.Senso
 	if err := dbSensor.InsertG(ctx, boil.Infer()); err != nil {
 		return confmodel.Sensor{}, fmt.Errorf("inserting DB sensor: %v", err)
 	}
-	return sensor, nil
+	notifyChange(dbSensor.ConfigurationID)
+	return toAppSensor(ctx, &dbSensor)
+}
+
+// InsertSensors inserts all sensors in one transaction. The sensors must belong
+// to the same configuration.
+func InsertSensors(ctx context.Context, sensors []confmodel.Sensor) ([]confmodel.Sensor, error) {
+	if len(sensors) == 0 {
+		return nil, nil
+	}
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var inserted []confmodel.Sensor
+	for _, sensor := range sensors {
+		dbSensor, err := toDbSensor(ctx, sensor)
+		if err != nil {
+			return nil, fmt.Errorf("creating DB sensor from App sensor: %v", err)
+		}
+		if err := dbSensor.Insert(ctx, tx, boil.Infer()); err != nil {
+			return nil, fmt.Errorf("inserting DB sensor %s: %v", sensor.Hostname, err)
+		}
+		sensor.ID = dbSensor.ID
+		inserted = append(inserted, sensor)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %v", err)
+	}
+	notifyChange(sensors[0].Config.ID)
+	return inserted, nil
 }
 
 func UpsertSensor(ctx context.Context, sensor confmodel.Sensor) (confmodel.Sensor, error) {
@@ -165,11 +286,12 @@ func UpsertSensor(ctx context.Context, sensor confmodel.Sensor) (confmodel.Senso
 	err = dbSensor.InsertG(ctx, boil.Infer())
 	if err != nil {
 		log.Debug("dbhelper", "updating sensor %v instead of inserting", dbSensor.MacAddress.String)
-		_, err = dbSensor.UpdateG(ctx, boil.Infer())
+		_, err = dbSensor.UpdateG(ctx, boil.Blacklist(sensorStateColumns...))
 	}
 	if err != nil {
 		return confmodel.Sensor{}, fmt.Errorf("upserting DB sensor: %v", err)
 	}
+	notifyChange(dbSensor.ConfigurationID)
 	return sensor, nil
 }
 
@@ -208,6 +330,13 @@ func GetSensor(ctx context.Context, sensorID int64) (confmodel.Sensor, error) {
 }
 
 func DeleteSensor(ctx context.Context, sensorID int64) error {
+	dbSensor, err := appdb.FindSensorG(ctx, sensorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("fetching sensor from database: %v", err)
+	}
 	count, err := appdb.Sensors(
 		appdb.SensorWhere.ID.EQ(sensorID),
 	).DeleteAllG(ctx)
@@ -220,6 +349,7 @@ func DeleteSensor(ctx context.Context, sensorID int64) error {
 	if count == 0 {
 		return ErrNotFound
 	}
+	notifyChange(dbSensor.ConfigurationID)
 	return nil
 }
 
@@ -239,9 +369,145 @@ func GetSensors(ctx context.Context) ([]confmodel.Sensor, error) {
 	return appSensors, nil
 }
 
+// QuerySensors returns the sensors matching the filter together with the number
+// of all matching sensors regardless of paging.
+func QuerySensors(ctx context.Context, filter confmodel.SensorFilter) ([]confmodel.Sensor, int64, error) {
+	var mods []qm.QueryMod
+	if filter.ConfigID != 0 {
+		mods = append(mods, appdb.SensorWhere.ConfigurationID.EQ(filter.ConfigID))
+	}
+	if filter.Hostname != "" {
+		mods = append(mods, qm.Where(appdb.SensorColumns.Hostname+" ilike ?", "%"+escapeLike(filter.Hostname)+"%"))
+	}
+	if filter.MACAddress != "" {
+		mods = append(mods, qm.Where("lower("+appdb.SensorColumns.MacAddress+") = lower(?)", filter.MACAddress))
+	}
+	if filter.Status != "" {
+		mods = append(mods, appdb.SensorWhere.Status.EQ(filter.Status))
+	}
+	if filter.DiscoveryMode != "" {
+		mods = append(mods, appdb.SensorWhere.DiscoveryMode.EQ(filter.DiscoveryMode))
+	}
+
+	total, err := appdb.Sensors(mods...).CountG(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("counting sensors: %v", err)
+	}
+
+	orderBy, err := sensorOrderBy(filter.Sort)
+	if err != nil {
+		return nil, 0, err
+	}
+	mods = append(mods, qm.OrderBy(orderBy))
+	if filter.Page > 0 {
+		mods = append(mods, qm.Limit(filter.PageSize), qm.Offset((filter.Page-1)*filter.PageSize))
+	}
+
+	dbSensors, err := appdb.Sensors(mods...).AllG(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("fetching sensors from database: %v", err)
+	}
+	appSensors := []confmodel.Sensor{}
+	for _, dbSensor := range dbSensors {
+		appSensor, err := toAppSensor(ctx, dbSensor)
+		if err != nil {
+			return nil, 0, fmt.Errorf("converting DB sensor to app sensor: %v", err)
+		}
+		appSensors = append(appSensors, appSensor)
+	}
+	return appSensors, total, nil
+}
+
+// SensorSortColumns are the columns sensors can be sorted by.
+var SensorSortColumns = []string{
+	appdb.SensorColumns.ID,
+	appdb.SensorColumns.Hostname,
+	appdb.SensorColumns.Port,
+	appdb.SensorColumns.MacAddress,
+	appdb.SensorColumns.DiscoveryMode,
+	appdb.SensorColumns.Status,
+	appdb.SensorColumns.LastSeen,
+}
+
+func sensorOrderBy(sort string) (string, error) {
+	if sort == "" {
+		return appdb.SensorColumns.ID, nil
+	}
+	direction := "asc"
+	if strings.HasPrefix(sort, "-") {
+		direction = "desc"
+		sort = sort[1:]
+	}
+	if !slices.Contains(SensorSortColumns, sort) {
+		return "", fmt.Errorf("%w: cannot sort by %q", ErrBadRequest, sort)
+	}
+	// The ID keeps the order stable for equal values.
+	return fmt.Sprintf("%s %s, %s", sort, direction, appdb.SensorColumns.ID), nil
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// sensorStateColumns are maintained by the collector and not changed by updates
+// of the sensor's settings.
+var sensorStateColumns = []string{
+	appdb.SensorColumns.Status,
+	appdb.SensorColumns.StatusMessage,
+	appdb.SensorColumns.LastSeen,
+	appdb.SensorColumns.CertFingerprint,
+}
+
+// SetSensorStatus records the result of collecting from the sensor. Successful
+// collections also update the time the sensor was last seen.
+func SetSensorStatus(ctx context.Context, sensorID int64, status string, message string) error {
+	cols := appdb.M{
+		appdb.SensorColumns.Status:        status,
+		appdb.SensorColumns.StatusMessage: null.NewString(message, message != ""),
+	}
+	if status == confmodel.SensorStatusOK {
+		cols[appdb.SensorColumns.LastSeen] = null.TimeFrom(time.Now())
+	}
+	_, err := appdb.Sensors(appdb.SensorWhere.ID.EQ(sensorID)).UpdateAllG(ctx, cols)
+	if err != nil {
+		return fmt.Errorf("updating status of sensor %d: %v", sensorID, err)
+	}
+	return nil
+}
+
+// SetSensorCertFingerprint pins the sensor's certificate. A nil fingerprint
+// removes the pin, so the certificate is pinned again on the next contact.
+func SetSensorCertFingerprint(ctx context.Context, sensorID int64, fingerprint *string) error {
+	rows, err := appdb.Sensors(appdb.SensorWhere.ID.EQ(sensorID)).UpdateAllG(ctx, appdb.M{
+		appdb.SensorColumns.CertFingerprint: null.StringFromPtr(fingerprint),
+	})
+	if err != nil {
+		return fmt.Errorf("updating certificate fingerprint of sensor %d: %v", sensorID, err)
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SetSensorMACAddress records the MAC address the sensor reported.
+func SetSensorMACAddress(ctx context.Context, sensorID int64, mac string) error {
+	rows, err := appdb.Sensors(appdb.SensorWhere.ID.EQ(sensorID)).UpdateAllG(ctx, appdb.M{
+		appdb.SensorColumns.MacAddress: mac,
+	})
+	if err != nil {
+		return fmt.Errorf("updating MAC address of sensor %d: %v", sensorID, err)
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
 func GetSensorsOfConfig(ctx context.Context, configID int64) ([]confmodel.Sensor, error) {
 	dbSensors, err := appdb.Sensors(
 		appdb.SensorWhere.ConfigurationID.EQ(configID),
+		qm.OrderBy(appdb.SensorColumns.ID),
 	).AllG(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("fetching sensors from database: %v", err)
@@ -267,10 +533,19 @@ func toDbSensor(ctx context.Context, appSensor confmodel.Sensor) (appdb.Sensor,
 		Password:        appSensor.Password,
 		Hostname:        appSensor.Hostname,
 		Port:            appSensor.Port,
+		ConnectHostname: null.StringFromPtr(appSensor.ConnectHostname),
+		ConnectPort:     null.Int32FromPtr(appSensor.ConnectPort),
 		DiscoveryMode:   appSensor.DiscoveryMode,
 		L3FirstIP:       null.StringFromPtr(appSensor.L3FirstIP),
 		L3Count:         null.Int32FromPtr(appSensor.L3Count),
 		MacAddress:      null.StringFromPtr(appSensor.MACAddress),
+		GroupName:       null.StringFromPtr(appSensor.Group),
+		ProjectIds:      appSensor.ProjectIDs,
+		ParentAssetID:   null.Int32FromPtr(appSensor.ParentAssetID),
+		Tag:             null.StringFromPtr(appSensor.Tag),
+		CertFingerprint: null.StringFromPtr(appSensor.CertFingerprint),
+
+		GeometryTransform: []float64(appSensor.GeometryTransform),
 	}
 
 	return dbSensor, nil
@@ -292,6 +567,12 @@ func toAppSensor(ctx context.Context, dbSensor *appdb.Sensor) (confmodel.Sensor,
 		DiscoveryMode: dbSensor.DiscoveryMode,
 	}
 
+	if dbSensor.ConnectHostname.Valid {
+		appSensor.ConnectHostname = &dbSensor.ConnectHostname.String
+	}
+	if dbSensor.ConnectPort.Valid {
+		appSensor.ConnectPort = &dbSensor.ConnectPort.Int32
+	}
 	if dbSensor.L3FirstIP.Valid {
 		appSensor.L3FirstIP = &dbSensor.L3FirstIP.String
 	}
@@ -302,10 +583,818 @@ func toAppSensor(ctx context.Context, dbSensor *appdb.Sensor) (confmodel.Sensor,
 	if dbSensor.MacAddress.Valid {
 		appSensor.MACAddress = &dbSensor.MacAddress.String
 	}
+	if dbSensor.GroupName.Valid {
+		appSensor.Group = &dbSensor.GroupName.String
+	}
+	if dbSensor.ProjectIds != nil {
+		appSensor.ProjectIDs = dbSensor.ProjectIds
+	}
+	if dbSensor.ParentAssetID.Valid {
+		appSensor.ParentAssetID = &dbSensor.ParentAssetID.Int32
+	}
+	if dbSensor.Tag.Valid {
+		appSensor.Tag = &dbSensor.Tag.String
+	}
+	if dbSensor.GeometryTransform != nil {
+		appSensor.GeometryTransform = confmodel.AffineTransform(dbSensor.GeometryTransform)
+	}
+	if dbSensor.CertFingerprint.Valid {
+		appSensor.CertFingerprint = &dbSensor.CertFingerprint.String
+	}
+
+	appSensor.Status = dbSensor.Status
+	if dbSensor.StatusMessage.Valid {
+		appSensor.StatusMessage = &dbSensor.StatusMessage.String
+	}
+	if dbSensor.LastSeen.Valid {
+		appSensor.LastSeen = &dbSensor.LastSeen.Time
+	}
 
 	return appSensor, nil
 }
 
+func GetGroupMappings(ctx context.Context, configID int64) ([]confmodel.GroupMapping, error) {
+	dbMappings, err := appdb.GroupMappings(
+		appdb.GroupMappingWhere.ConfigurationID.EQ(configID),
+		qm.OrderBy(appdb.GroupMappingColumns.GroupName),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching group mappings from database: %v", err)
+	}
+	var mappings []confmodel.GroupMapping
+	for _, dbMapping := range dbMappings {
+		mappings = append(mappings, toAppGroupMapping(dbMapping))
+	}
+	return mappings, nil
+}
+
+// UpsertGroupMapping creates the mapping of the group or replaces the existing one.
+func UpsertGroupMapping(ctx context.Context, mapping confmodel.GroupMapping) (confmodel.GroupMapping, error) {
+	dbMapping := appdb.GroupMapping{
+		ConfigurationID: mapping.ConfigID,
+		GroupName:       mapping.Group,
+		ProjectIds:      mapping.ProjectIDs,
+		ParentAssetID:   null.Int32FromPtr(mapping.ParentAssetID),
+	}
+	err := dbMapping.UpsertG(ctx, true,
+		[]string{appdb.GroupMappingColumns.ConfigurationID, appdb.GroupMappingColumns.GroupName},
+		boil.Whitelist(appdb.GroupMappingColumns.ProjectIds, appdb.GroupMappingColumns.ParentAssetID),
+		boil.Infer())
+	if err != nil {
+		return confmodel.GroupMapping{}, fmt.Errorf("upserting group mapping: %v", err)
+	}
+	notifyChange(mapping.ConfigID)
+	return toAppGroupMapping(&dbMapping), nil
+}
+
+func DeleteGroupMapping(ctx context.Context, configID int64, group string) error {
+	count, err := appdb.GroupMappings(
+		appdb.GroupMappingWhere.ConfigurationID.EQ(configID),
+		appdb.GroupMappingWhere.GroupName.EQ(group),
+	).DeleteAllG(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting group mapping from database: %v", err)
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+	notifyChange(configID)
+	return nil
+}
+
+func toAppGroupMapping(dbMapping *appdb.GroupMapping) confmodel.GroupMapping {
+	mapping := confmodel.GroupMapping{
+		ID:         dbMapping.ID,
+		ConfigID:   dbMapping.ConfigurationID,
+		Group:      dbMapping.GroupName,
+		ProjectIDs: dbMapping.ProjectIds,
+	}
+	if dbMapping.ParentAssetID.Valid {
+		mapping.ParentAssetID = &dbMapping.ParentAssetID.Int32
+	}
+	return mapping
+}
+
+// GetSensorHealth returns the health of the sensor with the serial number, or
+// an empty one if the sensor reported nothing yet.
+func GetSensorHealth(ctx context.Context, serialNumber string) (confmodel.SensorHealth, error) {
+	dbHealth, err := appdb.FindSensorHealthG(ctx, serialNumber)
+	if errors.Is(err, sql.ErrNoRows) {
+		return confmodel.SensorHealth{SerialNumber: serialNumber}, nil
+	}
+	if err != nil {
+		return confmodel.SensorHealth{}, fmt.Errorf("fetching sensor health: %v", err)
+	}
+	health := confmodel.SensorHealth{
+		SerialNumber: dbHealth.SerialNumber,
+		Tilted:       dbHealth.Tilted,
+		Covered:      dbHealth.Covered,
+		UpdatedAt:    dbHealth.UpdatedAt,
+	}
+	if dbHealth.Online.Valid {
+		health.Online = &dbHealth.Online.Bool
+	}
+	if dbHealth.Illumination.Valid {
+		health.Illumination = &dbHealth.Illumination.String
+	}
+	return health, nil
+}
+
+// UpsertSensorHealth stores the health of the sensor.
+func UpsertSensorHealth(ctx context.Context, health confmodel.SensorHealth) error {
+	dbHealth := appdb.SensorHealth{
+		SerialNumber: health.SerialNumber,
+		Online:       null.BoolFromPtr(health.Online),
+		Illumination: null.StringFromPtr(health.Illumination),
+		Tilted:       health.Tilted,
+		Covered:      health.Covered,
+		UpdatedAt:    health.UpdatedAt,
+	}
+	if err := dbHealth.UpsertG(ctx, true, []string{appdb.SensorHealthColumns.SerialNumber}, boil.Infer(), boil.Infer()); err != nil {
+		return fmt.Errorf("upserting sensor health: %v", err)
+	}
+	return nil
+}
+
+// AddHeatmap adds the counted positions to the stored heatmap of the same
+// zone and window, or stores it if there is none yet. Heatmaps with another
+// grid replace the stored one, as the zone was changed on the sensor. It is a
+// single upsert, so concurrent flushes of a new window do not lose cells.
+func AddHeatmap(ctx context.Context, heatmap confmodel.Heatmap) error {
+	dbHeatmap := toDbHeatmap(heatmap)
+	_, err := queries.Raw(`
+		insert into xovis2.heatmap as h (serial_number, geometry_id, geometry_name, window_start, window_end,
+			origin_x, origin_y, cell_size, grid_columns, grid_rows, cells, frames)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		on conflict (serial_number, geometry_id, window_start) do update
+		set cells = case when (h.origin_x, h.origin_y, h.cell_size, h.grid_columns, h.grid_rows, cardinality(h.cells))
+				= (excluded.origin_x, excluded.origin_y, excluded.cell_size, excluded.grid_columns, excluded.grid_rows, cardinality(excluded.cells))
+			then array(
+				select c.stored + c.added
+				from unnest(h.cells, excluded.cells) with ordinality as c(stored, added, i)
+				order by c.i
+			)
+			else excluded.cells end,
+		frames = case when (h.origin_x, h.origin_y, h.cell_size, h.grid_columns, h.grid_rows, cardinality(h.cells))
+				= (excluded.origin_x, excluded.origin_y, excluded.cell_size, excluded.grid_columns, excluded.grid_rows, cardinality(excluded.cells))
+			then h.frames + excluded.frames
+			else excluded.frames end,
+		geometry_name = excluded.geometry_name,
+		window_end = excluded.window_end,
+		origin_x = excluded.origin_x,
+		origin_y = excluded.origin_y,
+		cell_size = excluded.cell_size,
+		grid_columns = excluded.grid_columns,
+		grid_rows = excluded.grid_rows`,
+		dbHeatmap.SerialNumber, dbHeatmap.GeometryID, dbHeatmap.GeometryName, dbHeatmap.WindowStart, dbHeatmap.WindowEnd,
+		dbHeatmap.OriginX, dbHeatmap.OriginY, dbHeatmap.CellSize, dbHeatmap.GridColumns, dbHeatmap.GridRows, dbHeatmap.Cells, dbHeatmap.Frames,
+	).ExecContext(ctx, boil.GetContextDB())
+	if err != nil {
+		return fmt.Errorf("adding heatmap: %v", err)
+	}
+	return nil
+}
+
+// GetHeatmaps returns the heatmaps of the sensor with windows overlapping
+// the time range, ordered by window and zone.
+func GetHeatmaps(ctx context.Context, serialNumber string, from, to time.Time) ([]confmodel.Heatmap, error) {
+	dbHeatmaps, err := appdb.Heatmaps(
+		appdb.HeatmapWhere.SerialNumber.EQ(serialNumber),
+		appdb.HeatmapWhere.WindowEnd.GT(from),
+		appdb.HeatmapWhere.WindowStart.LT(to),
+		qm.OrderBy(appdb.HeatmapColumns.WindowStart+", "+appdb.HeatmapColumns.GeometryID),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching heatmaps: %v", err)
+	}
+	heatmaps := make([]confmodel.Heatmap, 0, len(dbHeatmaps))
+	for _, dbHeatmap := range dbHeatmaps {
+		heatmaps = append(heatmaps, confmodel.Heatmap{
+			SerialNumber: dbHeatmap.SerialNumber,
+			GeometryID:   dbHeatmap.GeometryID,
+			GeometryName: dbHeatmap.GeometryName,
+			WindowStart:  dbHeatmap.WindowStart,
+			WindowEnd:    dbHeatmap.WindowEnd,
+			OriginX:      dbHeatmap.OriginX,
+			OriginY:      dbHeatmap.OriginY,
+			CellSize:     dbHeatmap.CellSize,
+			Columns:      dbHeatmap.GridColumns,
+			Rows:         dbHeatmap.GridRows,
+			Cells:        dbHeatmap.Cells,
+			Frames:       dbHeatmap.Frames,
+		})
+	}
+	return heatmaps, nil
+}
+
+func toDbHeatmap(heatmap confmodel.Heatmap) *appdb.Heatmap {
+	return &appdb.Heatmap{
+		SerialNumber: heatmap.SerialNumber,
+		GeometryID:   heatmap.GeometryID,
+		GeometryName: heatmap.GeometryName,
+		WindowStart:  heatmap.WindowStart,
+		WindowEnd:    heatmap.WindowEnd,
+		OriginX:      heatmap.OriginX,
+		OriginY:      heatmap.OriginY,
+		CellSize:     heatmap.CellSize,
+		GridColumns:  heatmap.Columns,
+		GridRows:     heatmap.Rows,
+		Cells:        heatmap.Cells,
+		Frames:       heatmap.Frames,
+	}
+}
+
+// ReplaceLogicGeometries sets the geometries of the logics of the sensor as
+// read from the sensor. Points already stored are kept, geometries no longer
+// part of a logic are removed.
+func ReplaceLogicGeometries(ctx context.Context, serialNumber string, geometries []confmodel.Geometry) error {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	type key struct{ logicID, geometryID int32 }
+	current := make(map[key]bool, len(geometries))
+	for _, geometry := range geometries {
+		current[key{geometry.LogicID, geometry.GeometryID}] = true
+		dbGeometry := appdb.Geometry{
+			SerialNumber: serialNumber,
+			LogicID:      geometry.LogicID,
+			GeometryID:   geometry.GeometryID,
+			Name:         geometry.Name,
+			Type:         geometry.Type,
+			UpdatedAt:    time.Now(),
+		}
+		if err := dbGeometry.Upsert(ctx, tx, true, geometryKeyColumns, boil.Whitelist(appdb.GeometryColumns.Name, appdb.GeometryColumns.Type), boil.Infer()); err != nil {
+			return fmt.Errorf("upserting geometry: %v", err)
+		}
+	}
+	stored, err := appdb.Geometries(appdb.GeometryWhere.SerialNumber.EQ(serialNumber)).All(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("fetching geometries: %v", err)
+	}
+	for _, dbGeometry := range stored {
+		if current[key{dbGeometry.LogicID, dbGeometry.GeometryID}] {
+			continue
+		}
+		if _, err := dbGeometry.Delete(ctx, tx); err != nil {
+			return fmt.Errorf("deleting geometry: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing geometries: %v", err)
+	}
+	return nil
+}
+
+// UpsertGeometries stores the geometries including their points.
+func UpsertGeometries(ctx context.Context, geometries []confmodel.Geometry) error {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	for _, geometry := range geometries {
+		points, err := json.Marshal(geometry.Points)
+		if err != nil {
+			return fmt.Errorf("encoding points: %v", err)
+		}
+		dbGeometry := appdb.Geometry{
+			SerialNumber: geometry.SerialNumber,
+			LogicID:      geometry.LogicID,
+			GeometryID:   geometry.GeometryID,
+			Name:         geometry.Name,
+			Type:         geometry.Type,
+			Points:       null.JSONFrom(points),
+			UpdatedAt:    geometry.UpdatedAt,
+		}
+		if err := dbGeometry.Upsert(ctx, tx, true, geometryKeyColumns, boil.Infer(), boil.Infer()); err != nil {
+			return fmt.Errorf("upserting geometry: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing geometries: %v", err)
+	}
+	return nil
+}
+
+var geometryKeyColumns = []string{
+	appdb.GeometryColumns.SerialNumber,
+	appdb.GeometryColumns.LogicID,
+	appdb.GeometryColumns.GeometryID,
+}
+
+// GetGeometries returns the geometries of the sensor ordered by logic.
+func GetGeometries(ctx context.Context, serialNumber string) ([]confmodel.Geometry, error) {
+	dbGeometries, err := appdb.Geometries(
+		appdb.GeometryWhere.SerialNumber.EQ(serialNumber),
+		qm.OrderBy(appdb.GeometryColumns.LogicID+", "+appdb.GeometryColumns.GeometryID),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching geometries: %v", err)
+	}
+	geometries := make([]confmodel.Geometry, 0, len(dbGeometries))
+	for _, dbGeometry := range dbGeometries {
+		geometry := confmodel.Geometry{
+			SerialNumber: dbGeometry.SerialNumber,
+			LogicID:      dbGeometry.LogicID,
+			GeometryID:   dbGeometry.GeometryID,
+			Name:         dbGeometry.Name,
+			Type:         dbGeometry.Type,
+			UpdatedAt:    dbGeometry.UpdatedAt,
+		}
+		if dbGeometry.Points.Valid {
+			if err := json.Unmarshal(dbGeometry.Points.JSON, &geometry.Points); err != nil {
+				return nil, fmt.Errorf("decoding points of geometry %d: %v", dbGeometry.GeometryID, err)
+			}
+		}
+		geometries = append(geometries, geometry)
+	}
+	return geometries, nil
+}
+
+// EnqueueDatapush adds the datapush package to the queue. If the package is
+// identified, it is recorded as received in the same transaction. It returns
+// false without adding the package if it was received before.
+func EnqueueDatapush(ctx context.Context, item confmodel.QueuedDatapush, pkg *confmodel.DatapushPackage) (bool, error) {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if pkg != nil {
+		result, err := queries.Raw(`
+			insert into xovis2.datapush_package (serial_number, agent_id, package_id, first_frame)
+			values ($1, $2, $3, $4)
+			on conflict do nothing`,
+			pkg.SerialNumber, pkg.AgentID, pkg.PackageID, pkg.FirstFrame,
+		).ExecContext(ctx, tx)
+		if err != nil {
+			return false, fmt.Errorf("recording datapush package: %v", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return false, fmt.Errorf("recording datapush package: %v", err)
+		}
+		if rows == 0 {
+			return false, nil
+		}
+	}
+
+	dbItem := appdb.DatapushQueue{
+		ConfigurationID: item.ConfigurationID,
+		Source:          item.Source,
+		SerialNumber:    item.SerialNumber,
+		Payload:         item.Payload,
+	}
+	if err := dbItem.Insert(ctx, tx, boil.Infer()); err != nil {
+		return false, fmt.Errorf("queueing datapush package: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("committing transaction: %v", err)
+	}
+	return true, nil
+}
+
+// ClaimQueuedDatapush takes the oldest queued package which is due and locks
+// it for the given lease, counting the attempt. Packages of sensors with a
+// package locked by another worker are left, so the packages of a sensor are
+// processed one after the other. It returns nil if no package is due.
+//
+// The check for a locked package of the sensor alone would race, as two
+// workers claiming at the same time both find none under read committed and
+// take different packages of the sensor. The claim therefore holds an advisory
+// lock of the sensor until it is committed, and checks again after taking it,
+// when a package claimed by another worker before is visible.
+func ClaimQueuedDatapush(ctx context.Context, lease time.Duration) (*confmodel.QueuedDatapush, error) {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Sensors claimed by other workers right now are skipped.
+	skipped := types.StringArray{}
+	for {
+		var candidate struct {
+			ID           int64  `boil:"id"`
+			SerialNumber string `boil:"serial_number"`
+		}
+		err := queries.Raw(`
+			select q.id, q.serial_number from xovis2.datapush_queue q
+			where q.next_attempt_at <= now()
+			and (q.locked_until is null or q.locked_until < now())
+			and not exists (
+				select 1 from xovis2.datapush_queue l
+				where l.serial_number = q.serial_number and l.locked_until >= now()
+			)
+			and q.serial_number <> all($1)
+			order by q.next_attempt_at, q.id
+			limit 1
+			for update skip locked`,
+			skipped,
+		).Bind(ctx, tx, &candidate)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("finding queued datapush package: %v", err)
+		}
+
+		var lock struct {
+			Locked bool `boil:"locked"`
+		}
+		err = queries.Raw(`select pg_try_advisory_xact_lock(hashtext($1)) as locked`, candidate.SerialNumber).Bind(ctx, tx, &lock)
+		if err != nil {
+			return nil, fmt.Errorf("locking sensor %s: %v", candidate.SerialNumber, err)
+		}
+		if !lock.Locked {
+			skipped = append(skipped, candidate.SerialNumber)
+			continue
+		}
+
+		var dbItem appdb.DatapushQueue
+		err = queries.Raw(`
+			update xovis2.datapush_queue
+			set attempts = attempts + 1, locked_until = now() + make_interval(secs => $1)
+			where id = $2
+			and not exists (
+				select 1 from xovis2.datapush_queue l
+				where l.serial_number = $3 and l.locked_until >= now()
+			)
+			returning *`,
+			lease.Seconds(), candidate.ID, candidate.SerialNumber,
+		).Bind(ctx, tx, &dbItem)
+		if errors.Is(err, sql.ErrNoRows) {
+			// Another worker claimed a package of the sensor meanwhile.
+			skipped = append(skipped, candidate.SerialNumber)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("claiming queued datapush package: %v", err)
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, fmt.Errorf("committing transaction: %v", err)
+		}
+		item := toAppQueuedDatapush(dbItem)
+		return &item, nil
+	}
+}
+
+// CompleteQueuedDatapush removes the processed package from the queue.
+func CompleteQueuedDatapush(ctx context.Context, id int64) error {
+	if _, err := appdb.DatapushQueues(appdb.DatapushQueueWhere.ID.EQ(id)).DeleteAllG(ctx); err != nil {
+		return fmt.Errorf("deleting queued datapush package %d: %v", id, err)
+	}
+	return nil
+}
+
+// RetryQueuedDatapush unlocks the failed package and schedules its next
+// attempt. The package was processed, so its side effects are applied.
+func RetryQueuedDatapush(ctx context.Context, id int64, next time.Time, lastError string) error {
+	_, err := appdb.DatapushQueues(appdb.DatapushQueueWhere.ID.EQ(id)).UpdateAllG(ctx, appdb.M{
+		appdb.DatapushQueueColumns.NextAttemptAt:      next,
+		appdb.DatapushQueueColumns.LastError:          null.StringFrom(lastError),
+		appdb.DatapushQueueColumns.LockedUntil:        null.Time{},
+		appdb.DatapushQueueColumns.SideEffectsApplied: true,
+	})
+	if err != nil {
+		return fmt.Errorf("scheduling retry of queued datapush package %d: %v", id, err)
+	}
+	return nil
+}
+
+// DeadLetterQueuedDatapush moves the failed package from the queue to the
+// dead letters. processed tells that the package was processed, so that its
+// side effects are applied.
+func DeadLetterQueuedDatapush(ctx context.Context, id int64, lastError string, processed bool) error {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	_, err = queries.Raw(`
+		insert into xovis2.datapush_dead_letter (configuration_id, source, serial_number, payload, attempts, last_error, received_at, side_effects_applied)
+		select configuration_id, source, serial_number, payload, attempts, $2, received_at, side_effects_applied or $3
+		from xovis2.datapush_queue where id = $1`,
+		id, lastError, processed,
+	).ExecContext(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("inserting dead letter: %v", err)
+	}
+	if _, err := appdb.DatapushQueues(appdb.DatapushQueueWhere.ID.EQ(id)).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("deleting queued datapush package %d: %v", id, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %v", err)
+	}
+	return nil
+}
+
+// GetDeadLetters returns the latest dead letters, optionally only the ones of
+// the sensor with the given serial number.
+func GetDeadLetters(ctx context.Context, serialNumber string, limit int) ([]confmodel.DeadLetter, error) {
+	mods := []qm.QueryMod{
+		qm.OrderBy(appdb.DatapushDeadLetterColumns.FailedAt + " desc, " + appdb.DatapushDeadLetterColumns.ID + " desc"),
+		qm.Limit(limit),
+	}
+	if serialNumber != "" {
+		mods = append(mods, appdb.DatapushDeadLetterWhere.SerialNumber.EQ(serialNumber))
+	}
+	dbDeadLetters, err := appdb.DatapushDeadLetters(mods...).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching dead letters: %v", err)
+	}
+	deadLetters := make([]confmodel.DeadLetter, 0, len(dbDeadLetters))
+	for _, dbDeadLetter := range dbDeadLetters {
+		deadLetters = append(deadLetters, toAppDeadLetter(dbDeadLetter))
+	}
+	return deadLetters, nil
+}
+
+func GetDeadLetter(ctx context.Context, id int64) (confmodel.DeadLetter, error) {
+	dbDeadLetter, err := appdb.FindDatapushDeadLetterG(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return confmodel.DeadLetter{}, ErrNotFound
+	}
+	if err != nil {
+		return confmodel.DeadLetter{}, fmt.Errorf("fetching dead letter: %v", err)
+	}
+	return toAppDeadLetter(dbDeadLetter), nil
+}
+
+// ReplayDeadLetter moves the dead letter back to the queue, where it is
+// processed again with all attempts. Its side effects are not applied again.
+func ReplayDeadLetter(ctx context.Context, id int64) error {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	result, err := queries.Raw(`
+		insert into xovis2.datapush_queue (configuration_id, source, serial_number, payload, last_error, received_at, side_effects_applied)
+		select configuration_id, source, serial_number, payload, last_error, received_at, side_effects_applied
+		from xovis2.datapush_dead_letter where id = $1`,
+		id,
+	).ExecContext(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("queueing dead letter: %v", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("queueing dead letter: %v", err)
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	if _, err := appdb.DatapushDeadLetters(appdb.DatapushDeadLetterWhere.ID.EQ(id)).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("deleting dead letter: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %v", err)
+	}
+	return nil
+}
+
+func DeleteDeadLetter(ctx context.Context, id int64) error {
+	count, err := appdb.DatapushDeadLetters(appdb.DatapushDeadLetterWhere.ID.EQ(id)).DeleteAllG(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting dead letter: %v", err)
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ArchiveDatapush stores the datapush body and removes the bodies of the
+// sensor exceeding the archive limits of the configuration, the oldest first.
+func ArchiveDatapush(ctx context.Context, config confmodel.Configuration, item confmodel.ArchivedDatapush) error {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	dbItem := appdb.DatapushArchive{
+		ConfigurationID: config.ID,
+		Source:          item.Source,
+		SerialNumber:    item.SerialNumber,
+		ContentType:     item.ContentType,
+		Body:            item.Body,
+		Size:            int32(len(item.Body)),
+	}
+	if err := dbItem.Insert(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("archiving datapush: %v", err)
+	}
+	_, err = queries.Raw(`
+		delete from xovis2.datapush_archive where id in (
+			select id from (
+				select id, received_at, sum(size) over (order by received_at desc, id desc) as total
+				from xovis2.datapush_archive
+				where configuration_id = $1 and serial_number = $2
+			) a
+			where a.total > $3 or a.received_at < now() - make_interval(secs => $4)
+		)`,
+		config.ID, item.SerialNumber, config.ArchiveMaxSize, config.ArchiveMaxAge,
+	).ExecContext(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("removing archived datapushes: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %v", err)
+	}
+	return nil
+}
+
+// DeleteExpiredArchive removes the archived datapushes older than the maximum
+// age of their configuration.
+func DeleteExpiredArchive(ctx context.Context) (int64, error) {
+	result, err := queries.Raw(`
+		delete from xovis2.datapush_archive a
+		using xovis2.configuration c
+		where a.configuration_id = c.id
+		and a.received_at < now() - make_interval(secs => c.archive_max_age)`,
+	).ExecContext(ctx, boil.GetContextDB())
+	if err != nil {
+		return 0, fmt.Errorf("removing expired archived datapushes: %v", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("removing expired archived datapushes: %v", err)
+	}
+	return rows, nil
+}
+
+// GetArchivedDatapushes returns the archived datapushes matching the filter,
+// the oldest first. The bodies are only read if withBody is set.
+func GetArchivedDatapushes(ctx context.Context, filter confmodel.ArchiveFilter, withBody bool) ([]confmodel.ArchivedDatapush, error) {
+	mods := []qm.QueryMod{
+		qm.OrderBy(appdb.DatapushArchiveColumns.ReceivedAt + ", " + appdb.DatapushArchiveColumns.ID),
+	}
+	if !withBody {
+		mods = append(mods, qm.Select(
+			appdb.DatapushArchiveColumns.ID,
+			appdb.DatapushArchiveColumns.ConfigurationID,
+			appdb.DatapushArchiveColumns.Source,
+			appdb.DatapushArchiveColumns.SerialNumber,
+			appdb.DatapushArchiveColumns.ContentType,
+			appdb.DatapushArchiveColumns.Size,
+			appdb.DatapushArchiveColumns.ReceivedAt,
+		))
+	}
+	if filter.ConfigurationID != 0 {
+		mods = append(mods, appdb.DatapushArchiveWhere.ConfigurationID.EQ(filter.ConfigurationID))
+	}
+	if filter.SerialNumber != "" {
+		mods = append(mods, appdb.DatapushArchiveWhere.SerialNumber.EQ(filter.SerialNumber))
+	}
+	if !filter.From.IsZero() {
+		mods = append(mods, appdb.DatapushArchiveWhere.ReceivedAt.GTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		mods = append(mods, appdb.DatapushArchiveWhere.ReceivedAt.LT(filter.To))
+	}
+	if len(filter.IDs) > 0 {
+		mods = append(mods, appdb.DatapushArchiveWhere.ID.IN(filter.IDs))
+	}
+	if filter.Limit > 0 {
+		mods = append(mods, qm.Limit(filter.Limit))
+	}
+	dbItems, err := appdb.DatapushArchives(mods...).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching archived datapushes: %v", err)
+	}
+	items := make([]confmodel.ArchivedDatapush, 0, len(dbItems))
+	for _, dbItem := range dbItems {
+		items = append(items, toAppArchivedDatapush(dbItem))
+	}
+	return items, nil
+}
+
+func GetArchivedDatapush(ctx context.Context, id int64) (confmodel.ArchivedDatapush, error) {
+	dbItem, err := appdb.FindDatapushArchiveG(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return confmodel.ArchivedDatapush{}, ErrNotFound
+	}
+	if err != nil {
+		return confmodel.ArchivedDatapush{}, fmt.Errorf("fetching archived datapush: %v", err)
+	}
+	return toAppArchivedDatapush(dbItem), nil
+}
+
+func toAppArchivedDatapush(dbItem *appdb.DatapushArchive) confmodel.ArchivedDatapush {
+	return confmodel.ArchivedDatapush{
+		ID:              dbItem.ID,
+		ConfigurationID: dbItem.ConfigurationID,
+		Source:          dbItem.Source,
+		SerialNumber:    dbItem.SerialNumber,
+		ContentType:     dbItem.ContentType,
+		Body:            dbItem.Body,
+		Size:            dbItem.Size,
+		ReceivedAt:      dbItem.ReceivedAt,
+	}
+}
+
+func toAppQueuedDatapush(dbItem appdb.DatapushQueue) confmodel.QueuedDatapush {
+	return confmodel.QueuedDatapush{
+		ID:                 dbItem.ID,
+		ConfigurationID:    dbItem.ConfigurationID,
+		Source:             dbItem.Source,
+		SerialNumber:       dbItem.SerialNumber,
+		Payload:            dbItem.Payload,
+		Attempts:           dbItem.Attempts,
+		LastError:          dbItem.LastError.String,
+		ReceivedAt:         dbItem.ReceivedAt,
+		SideEffectsApplied: dbItem.SideEffectsApplied,
+	}
+}
+
+func toAppDeadLetter(dbDeadLetter *appdb.DatapushDeadLetter) confmodel.DeadLetter {
+	return confmodel.DeadLetter{
+		QueuedDatapush: confmodel.QueuedDatapush{
+			ID:                 dbDeadLetter.ID,
+			ConfigurationID:    dbDeadLetter.ConfigurationID,
+			Source:             dbDeadLetter.Source,
+			SerialNumber:       dbDeadLetter.SerialNumber,
+			Payload:            dbDeadLetter.Payload,
+			Attempts:           dbDeadLetter.Attempts,
+			LastError:          dbDeadLetter.LastError,
+			ReceivedAt:         dbDeadLetter.ReceivedAt,
+			SideEffectsApplied: dbDeadLetter.SideEffectsApplied,
+		},
+		FailedAt: dbDeadLetter.FailedAt,
+	}
+}
+
+// DeleteDatapushPackages removes the packages received before the given time.
+func DeleteDatapushPackages(ctx context.Context, before time.Time) (int64, error) {
+	rows, err := appdb.DatapushPackages(appdb.DatapushPackageWhere.ReceivedAt.LT(before)).DeleteAllG(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("deleting datapush packages: %v", err)
+	}
+	return rows, nil
+}
+
+// ClaimCounterFrame records the frame as the last one written for the counter
+// of the sensor. It returns false if the count of a later frame was written
+// before. Frames are ordered by time, then by frame number. The same frame is
+// claimed again, so that its count can be written again if writing it failed.
+func ClaimCounterFrame(ctx context.Context, serialNumber string, counterID int32, frameTime int64, frameNumber int64) (bool, error) {
+	result, err := queries.Raw(`
+		insert into xovis2.counter_frame as c (serial_number, counter_id, frame_time, frame_number)
+		values ($1, $2, $3, $4)
+		on conflict (serial_number, counter_id) do update
+		set frame_time = excluded.frame_time, frame_number = excluded.frame_number
+		where (c.frame_time, c.frame_number) <= (excluded.frame_time, excluded.frame_number)`,
+		serialNumber, counterID, frameTime, frameNumber,
+	).ExecContext(ctx, boil.GetContextDB())
+	if err != nil {
+		return false, fmt.Errorf("recording frame of counter %d: %v", counterID, err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("recording frame of counter %d: %v", counterID, err)
+	}
+	return rows == 1, nil
+}
+
+// AddClassCounts adds the crossings per class to those counted before for the
+// counter of the sensor. It returns the total counts of the classes.
+func AddClassCounts(ctx context.Context, serialNumber string, counterID int32, counts map[string]int64) (map[string]int64, error) {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	totals := make(map[string]int64, len(counts))
+	for class, count := range counts {
+		var dbCount appdb.ClassCount
+		err := queries.Raw(`
+			insert into xovis2.class_count as c (serial_number, counter_id, class, count)
+			values ($1, $2, $3, $4)
+			on conflict (serial_number, counter_id, class) do update
+			set count = c.count + excluded.count
+			returning *`,
+			serialNumber, counterID, class, count,
+		).Bind(ctx, tx, &dbCount)
+		if err != nil {
+			return nil, fmt.Errorf("adding %s count of counter %d: %v", class, counterID, err)
+		}
+		totals[class] = dbCount.Count
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %v", err)
+	}
+	return totals, nil
+}
+
 func SetConfigActiveState(ctx context.Context, config confmodel.Configuration, state bool) (int64, error) {
 	return appdb.Configurations(
 		appdb.ConfigurationWhere.ID.EQ(config.ID),
@@ -353,6 +1442,24 @@ func toAppAsset(dbAsset appdb.Asset, config confmodel.Configuration) confmodel.A
 	}
 }
 
+// GetConfigAssetByGAI returns the asset with the GAI created by the
+// configuration. If the configuration created it in several projects, any of
+// them is returned.
+func GetConfigAssetByGAI(ctx context.Context, config confmodel.Configuration, gai string) (confmodel.Asset, error) {
+	asset, err := appdb.Assets(
+		appdb.AssetWhere.ConfigurationID.EQ(config.ID),
+		appdb.AssetWhere.GlobalAssetID.EQ(gai),
+		appdb.AssetWhere.AssetID.IsNotNull(),
+	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return confmodel.Asset{}, ErrNotFound
+	}
+	if err != nil {
+		return confmodel.Asset{}, fmt.Errorf("fetching asset: %v", err)
+	}
+	return toAppAsset(*asset, config), nil
+}
+
 func GetAssetById(assetId int32) (confmodel.Asset, error) {
 	asset, err := appdb.Assets(
 		appdb.AssetWhere.AssetID.EQ(null.Int32From(assetId)),
@@ -28,6 +28,55 @@ create table if not exists xovis2.configuration
 	user_id              text not null
 );
 
+-- Shape of the asset tree: people counters directly under the root (flat), in
+-- assets for their Xovis group (group) or for the tag set on the sensor (tag).
+alter table xovis2.configuration add column if not exists hierarchy_mode text not null default 'group'
+	check (hierarchy_mode in ('flat', 'group', 'tag'));
+
+-- PEM encoded CA certificates the sensor certificates are verified against in
+-- addition to the system's CAs.
+alter table xovis2.configuration add column if not exists ca_bundle text;
+
+-- Proxy the sensors are reached through: http://, https:// (HTTP CONNECT) or
+-- socks5:// URL, optionally with credentials.
+alter table xovis2.configuration add column if not exists proxy_url text;
+
+-- MQTT broker the sensors push their data to, e.g. tcp://broker:1883 or
+-- ssl://broker:8883. Null to receive datapushes through the webhook only.
+alter table xovis2.configuration add column if not exists mqtt_broker_url text;
+alter table xovis2.configuration add column if not exists mqtt_topics text[] not null default '{}';
+alter table xovis2.configuration add column if not exists mqtt_username text;
+alter table xovis2.configuration add column if not exists mqtt_password text;
+
+-- Counts of lines and zones are written by the logics push of the sensors only,
+-- the collection just creates their assets.
+alter table xovis2.configuration add column if not exists prefer_logics_push boolean not null default false;
+
+-- Alarm rules are created for the health attributes of the people counters.
+alter table xovis2.configuration add column if not exists health_alarms boolean not null default false;
+
+-- Tracked objects of live pushes are counted per object type and their
+-- positions in zones accumulated in heatmaps with square cells of
+-- heatmap_cell_size meters for windows of heatmap_window seconds.
+alter table xovis2.configuration add column if not exists tracking_enabled  boolean not null default false;
+alter table xovis2.configuration add column if not exists heatmap_cell_size double precision not null default 0.5;
+alter table xovis2.configuration add column if not exists heatmap_window    integer not null default 900;
+
+-- The raw datapush bodies are archived per sensor for archive_max_age seconds,
+-- keeping at most archive_max_size bytes per sensor.
+alter table xovis2.configuration add column if not exists archive_enabled  boolean not null default false;
+alter table xovis2.configuration add column if not exists archive_max_age  integer not null default 604800;
+alter table xovis2.configuration add column if not exists archive_max_size bigint not null default 10485760;
+
+-- Tracked objects are classified as staff if tagged with staff_tag, as
+-- children if shorter than child_max_height meters, as groups if of at least
+-- group_min_members members and as adults otherwise. Lines and zones get
+-- separate counts per class.
+alter table xovis2.configuration add column if not exists classification_enabled boolean not null default false;
+alter table xovis2.configuration add column if not exists child_max_height       double precision not null default 1.4;
+alter table xovis2.configuration add column if not exists group_min_members      integer not null default 2;
+alter table xovis2.configuration add column if not exists staff_tag              text not null default 'staff';
+
 -- Should be editable by eliona frontend.
 create table if not exists xovis2.sensor
 (
@@ -45,6 +94,52 @@ create table if not exists xovis2.sensor
 	mac_address text unique
 );
 
+-- Overrides for the assets created for the sensor. If null, the group set on
+-- the sensor and the projects of the configuration are used.
+alter table xovis2.sensor add column if not exists group_name  text;
+alter table xovis2.sensor add column if not exists project_ids text[];
+
+-- Result of the last collection from the sensor: unknown, ok, error,
+-- unsupported or security.
+alter table xovis2.sensor add column if not exists status         text not null default 'unknown';
+alter table xovis2.sensor add column if not exists status_message text;
+alter table xovis2.sensor add column if not exists last_seen      timestamptz;
+
+-- Existing Eliona asset the sensor's assets are placed under instead of the
+-- xovis group. Overrides the parent asset of the group mapping.
+alter table xovis2.sensor add column if not exists parent_asset_id integer;
+
+-- Custom tag grouping the sensor's assets if the configuration's hierarchy
+-- mode is tag.
+alter table xovis2.sensor add column if not exists tag text;
+
+-- Affine transform from the sensor's coordinates to the coordinates of a floor
+-- plan as {a, b, c, d, e, f}: x' = a*x + b*y + c, y' = d*x + e*y + f. Null if
+-- the geometries are used in the sensor's coordinates.
+alter table xovis2.sensor add column if not exists geometry_transform double precision[];
+
+-- SHA-256 fingerprint of the sensor certificate, pinned on first contact if
+-- the configuration does not check certificates.
+alter table xovis2.sensor add column if not exists cert_fingerprint text;
+
+-- Address the app connects to if the sensor's own address is not reachable,
+-- e.g. through NAT or a port mapping. Hostname and port keep the address
+-- the sensor has in its network.
+alter table xovis2.sensor add column if not exists connect_hostname text;
+alter table xovis2.sensor add column if not exists connect_port     int;
+
+-- Overrides for the assets of all sensors in a group. Settings on the sensor
+-- take precedence.
+create table if not exists xovis2.group_mapping
+(
+	id               bigserial primary key,
+	configuration_id bigserial not null references xovis2.configuration(id) ON DELETE CASCADE,
+	group_name       text not null,
+	project_ids      text[],
+	parent_asset_id  integer,
+	unique (configuration_id, group_name)
+);
+
 create table if not exists xovis2.asset
 (
 	id               bigserial primary key,
@@ -55,6 +150,145 @@ create table if not exists xovis2.asset
 	asset_id         integer
 );
 
+-- Health of the sensors as reported by their status and live pushes. The pushes
+-- identify the sensors by serial number only.
+create table if not exists xovis2.sensor_health
+(
+	serial_number    text primary key,
+	online           boolean,
+	illumination     text,
+	tilted           boolean not null default false,
+	covered          boolean not null default false,
+	updated_at       timestamptz not null default now()
+);
+
+-- Positions of the tracked objects in a zone geometry of a sensor, counted in
+-- a grid for a time window. Cells are stored row by row, starting at the
+-- origin, which is the corner of the zone with the smallest coordinates.
+create table if not exists xovis2.heatmap
+(
+	id               bigserial primary key,
+	serial_number    text not null,
+	geometry_id      integer not null,
+	geometry_name    text not null,
+	window_start     timestamptz not null,
+	window_end       timestamptz not null,
+	origin_x         double precision not null,
+	origin_y         double precision not null,
+	cell_size        double precision not null,
+	grid_columns     integer not null,
+	grid_rows        integer not null,
+	cells            bigint[] not null,
+	frames           integer not null,
+	unique (serial_number, geometry_id, window_start)
+);
+
+-- Geometries of the logics of a sensor. Names and types are read by the
+-- collection, the points in meters in the sensor's coordinates come with the
+-- configuration of live pushes and are null until then.
+create table if not exists xovis2.geometry
+(
+	serial_number    text not null,
+	logic_id         integer not null,
+	geometry_id      integer not null,
+	name             text not null,
+	type             text not null,
+	points           jsonb,
+	updated_at       timestamptz not null default now(),
+	primary key (serial_number, logic_id, geometry_id)
+);
+
+-- Live data packages already processed, to skip packages pushed again by the
+-- sensors. A package is identified by its ID, the push agent and its first
+-- frame, as package IDs start again after a restart of the sensor. Rows are
+-- removed after a day.
+create table if not exists xovis2.datapush_package
+(
+	serial_number    text not null,
+	agent_id         integer not null,
+	package_id       bigint not null,
+	first_frame      bigint not null,
+	received_at      timestamptz not null default now(),
+	primary key (serial_number, agent_id, package_id, first_frame)
+);
+
+-- Last frame whose count was written per counter of a sensor. Counts of older
+-- frames are skipped, so late pushes do not move counters backwards.
+create table if not exists xovis2.counter_frame
+(
+	serial_number    text not null,
+	counter_id       integer not null,
+	frame_time       bigint not null, -- Unix time in milliseconds
+	frame_number     bigint not null,
+	primary key (serial_number, counter_id)
+);
+
+-- Crossings of the lines of a sensor counted per class of the tracked objects,
+-- since the classification was enabled.
+create table if not exists xovis2.class_count
+(
+	serial_number    text not null,
+	counter_id       integer not null,
+	class            text not null,
+	count            bigint not null,
+	primary key (serial_number, counter_id, class)
+);
+
+-- Datapush packages received but not yet processed. Packages whose processing
+-- failed are retried with increasing delays until they are moved to the dead
+-- letters. A claimed package is locked until locked_until, after which another
+-- worker takes it over. side_effects_applied is set once the data accumulated
+-- by the app, like tracked objects, was counted, so that it is not counted
+-- again by later attempts.
+create table if not exists xovis2.datapush_queue
+(
+	id               bigserial primary key,
+	configuration_id bigint not null, -- configuration the package was received for
+	source           text not null, -- webhook or mqtt
+	serial_number    text not null,
+	payload          jsonb not null,
+	attempts         integer not null default 0,
+	last_error       text,
+	received_at      timestamptz not null default now(),
+	next_attempt_at  timestamptz not null default now(),
+	locked_until     timestamptz,
+	side_effects_applied boolean not null default false
+);
+
+create index if not exists datapush_queue_next_attempt_idx on xovis2.datapush_queue (next_attempt_at);
+
+-- Datapush packages which could not be processed after all attempts. They are
+-- kept until they are replayed or deleted through the API.
+create table if not exists xovis2.datapush_dead_letter
+(
+	id               bigserial primary key,
+	configuration_id bigint not null,
+	source           text not null,
+	serial_number    text not null,
+	payload          jsonb not null,
+	attempts         integer not null,
+	last_error       text not null,
+	received_at      timestamptz not null,
+	failed_at        timestamptz not null default now(),
+	side_effects_applied boolean not null default false
+);
+
+-- Raw datapush bodies as received, if archive_enabled is set in the
+-- configuration. They can be replayed through the API.
+create table if not exists xovis2.datapush_archive
+(
+	id               bigserial primary key,
+	configuration_id bigint not null references xovis2.configuration(id) on delete cascade,
+	source           text not null, -- webhook or mqtt
+	serial_number    text not null,
+	content_type     text not null,
+	body             bytea not null,
+	size             integer not null,
+	received_at      timestamptz not null default now()
+);
+
+create index if not exists datapush_archive_serial_idx on xovis2.datapush_archive (serial_number, received_at);
+
 -- There is a transaction started in app.Init(). We need to commit to make the
 -- new objects available for all other init steps.
 -- Chain starts the same transaction again.
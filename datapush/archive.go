@@ -0,0 +1,75 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package datapush
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+	"xovis/conf"
+	confmodel "xovis/model/conf"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// lastArchivePrune is the Unix time of the last removal of expired bodies.
+var lastArchivePrune atomic.Int64
+
+// Archive stores the raw body of a datapush received for the configuration if
+// archiving is enabled in it. Failures are only logged, as the package is
+// processed without the archive.
+func Archive(ctx context.Context, source string, configID int64, contentType string, body []byte, data Data) {
+	config, err := conf.GetConfig(ctx, configID)
+	if errors.Is(err, conf.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		log.Error("datapush", "Archiving datapush: %v", err)
+		return
+	}
+	if !config.ArchiveEnabled {
+		return
+	}
+	pruneArchive(ctx)
+
+	err = conf.ArchiveDatapush(ctx, config, confmodel.ArchivedDatapush{
+		Source:       source,
+		SerialNumber: data.SerialNumber(),
+		ContentType:  contentType,
+		Body:         body,
+	})
+	if err != nil {
+		log.Error("datapush", "%v", err)
+	}
+}
+
+// pruneArchive removes the bodies older than the maximum age of their
+// configuration, at most every pruneInterval. Archiving a body removes the
+// expired ones of its sensor, this catches the sensors which stopped pushing.
+func pruneArchive(ctx context.Context) {
+	now := time.Now()
+	last := lastArchivePrune.Load()
+	if now.Sub(time.Unix(last, 0)) < pruneInterval || !lastArchivePrune.CompareAndSwap(last, now.Unix()) {
+		return
+	}
+	deleted, err := conf.DeleteExpiredArchive(ctx)
+	if err != nil {
+		log.Error("datapush", "%v", err)
+		return
+	}
+	log.Debug("datapush", "removed %d expired archived datapushes", deleted)
+}
@@ -0,0 +1,352 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package datapush
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"xovis/conf"
+	"xovis/eliona"
+	confmodel "xovis/model/conf"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// Classes of the tracked objects, as used in the attribute names of the lines
+// and zones. Visitors are the adults and children, i.e. the persons who are
+// not staff.
+const (
+	classAdult    = "adults"
+	classChild    = "children"
+	classGroup    = "groups"
+	classStaff    = "staff"
+	classVisitors = "visitors"
+)
+
+// tagChild is the tag of tracked objects recognized as children by the
+// sensor, regardless of their height.
+const tagChild = "child"
+
+// trackClassTTL is how long the class of a track is kept after it was last
+// seen, for counts of frames which do not include the object.
+const trackClassTTL = time.Minute
+
+// classification holds the state of the classification of the tracked
+// objects of all sensors.
+var classification = classifier{
+	zones:      map[string]map[int][][][]float64{},
+	tracks:     map[string]map[int]trackClass{},
+	zoneCounts: map[zoneKey]map[string]any{},
+}
+
+type classifier struct {
+	mu sync.Mutex
+
+	// Polygons of the zones per logic of the sensors.
+	zones map[string]map[int][][][]float64
+
+	// Classes of the tracks of the sensors, by track ID.
+	tracks map[string]map[int]trackClass
+
+	// Counts last written to the zones.
+	zoneCounts map[zoneKey]map[string]any
+}
+
+type trackClass struct {
+	class string
+	seen  time.Time
+}
+
+type zoneKey struct {
+	serialNumber string
+	logicID      int
+}
+
+// crossingKey identifies the counter of a line crossed by a track.
+type crossingKey struct {
+	counterID int // as in the count events, e.g. 1008001
+	direction string
+}
+
+// processClassification classifies the tracked objects of the sensor and
+// writes the crossings of its lines per class and the objects in its zones
+// per class, if the classification is enabled for the configuration of the
+// people counter. Crossings are taken from the count events of the lines
+// with the class of the tracked object causing them.
+func processClassification(ctx context.Context, data Data) {
+	live := data.LiveData
+	serialNumber := live.SensorInfo.SerialNumber
+	if serialNumber == "" || len(live.Frames) == 0 {
+		return
+	}
+	counter, ok, err := peopleCounterAsset(serialNumber)
+	if err != nil {
+		log.Error("datapush", "%v", err)
+		return
+	}
+	if !ok || !counter.Config.ClassificationEnabled {
+		return
+	}
+
+	zones := logicZones(ctx, serialNumber, data)
+	crossings, zoneCounts := classification.add(serialNumber, data, zones, counter.Config, time.Now())
+
+	for key, counts := range crossings {
+		writeCrossings(ctx, serialNumber, key, counts)
+	}
+	for logicID, counts := range zoneCounts {
+		gai := fmt.Sprintf("xovis_zone_%v_%v", serialNumber, logicID)
+		asset, err := conf.GetAssetByGAI(gai)
+		if errors.Is(err, conf.ErrNotFound) {
+			classification.forgetZone(serialNumber, logicID)
+			continue
+		}
+		if err != nil {
+			log.Error("datapush", "getting asset by GAI %s: %v", gai, err)
+			classification.forgetZone(serialNumber, logicID)
+			continue
+		}
+		if err := eliona.UpsertAssetDataAt(ctx, asset.Config, asset.AssetID, counts, time.Time{}); err != nil {
+			log.Error("datapush", "upserting classified objects: %v", err)
+			classification.forgetZone(serialNumber, logicID)
+			continue
+		}
+		log.Debug("datapush", "set %v data %+v", asset.AssetID, counts)
+	}
+}
+
+// writeCrossings adds the crossings per class to the counts of the line and
+// writes the totals to its asset. Counters of zones are skipped.
+func writeCrossings(ctx context.Context, serialNumber string, key crossingKey, counts map[string]int64) {
+	gai := fmt.Sprintf("xovis_line_%v_%v", serialNumber, key.counterID/1000)
+	asset, err := conf.GetAssetByGAI(gai)
+	if errors.Is(err, conf.ErrNotFound) {
+		return // a zone or a line without asset
+	}
+	if err != nil {
+		log.Error("datapush", "getting asset by GAI %s: %v", gai, err)
+		return
+	}
+	totals, err := conf.AddClassCounts(ctx, serialNumber, int32(key.counterID), counts)
+	if err != nil {
+		log.Error("datapush", "counting crossings of line %s: %v", gai, err)
+		return
+	}
+	dataToUpsert := make(map[string]any, len(totals))
+	for class, total := range totals {
+		dataToUpsert[key.direction+"_"+class] = total
+	}
+	if err := eliona.UpsertAssetDataAt(ctx, asset.Config, asset.AssetID, dataToUpsert, time.Time{}); err != nil {
+		log.Error("datapush", "upserting crossings per class: %v", err)
+		return
+	}
+	log.Debug("datapush", "set %v data %+v", asset.AssetID, dataToUpsert)
+}
+
+// logicZones returns the polygons of the zones per logic of the sensor, from
+// the configuration in the push if included and from the stored geometries
+// otherwise.
+func logicZones(ctx context.Context, serialNumber string, data Data) map[int][][][]float64 {
+	live := data.LiveData
+	if len(live.Config.Logics) > 0 && len(live.Config.Geometries) > 0 {
+		var geometries []confmodel.Geometry
+		for _, logic := range live.Config.Logics {
+			for _, id := range logic.Geometries {
+				for _, geometry := range live.Config.Geometries {
+					if geometry.ID == id {
+						geometries = append(geometries, confmodel.Geometry{LogicID: int32(logic.ID), Type: geometry.Type, Points: geometry.Geometry})
+					}
+				}
+			}
+		}
+		return classification.setZones(serialNumber, geometries)
+	}
+	if zones, ok := classification.getZones(serialNumber); ok {
+		return zones
+	}
+	geometries, err := conf.GetGeometries(ctx, serialNumber)
+	if err != nil {
+		log.Error("datapush", "getting geometries of sensor %s: %v", serialNumber, err)
+		return nil
+	}
+	return classification.setZones(serialNumber, geometries)
+}
+
+func (c *classifier) getZones(serialNumber string) (map[int][][][]float64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	zones, ok := c.zones[serialNumber]
+	return zones, ok
+}
+
+func (c *classifier) setZones(serialNumber string, geometries []confmodel.Geometry) map[int][][][]float64 {
+	zones := map[int][][][]float64{}
+	for _, geometry := range geometries {
+		if strings.EqualFold(geometry.Type, confmodel.GeometryZone) && len(geometry.Points) >= 3 {
+			zones[int(geometry.LogicID)] = append(zones[int(geometry.LogicID)], geometry.Points)
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.zones[serialNumber] = zones
+	return zones
+}
+
+// forgetZone drops the counts last written to the zone, so that they are
+// written again with the next push.
+func (c *classifier) forgetZone(serialNumber string, logicID int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.zoneCounts, zoneKey{serialNumber: serialNumber, logicID: logicID})
+}
+
+// add classifies the tracked objects of the frames of the push. It returns the
+// crossings per class of the count events and the objects per class in the
+// zones in the last frame with tracked objects, for the zones whose counts
+// changed.
+func (c *classifier) add(serialNumber string, data Data, zones map[int][][][]float64, config confmodel.Configuration, now time.Time) (map[crossingKey]map[string]int64, map[int]map[string]any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tracks := c.tracks[serialNumber]
+	if tracks == nil {
+		tracks = map[int]trackClass{}
+		c.tracks[serialNumber] = tracks
+	}
+	for id, track := range tracks {
+		if now.Sub(track.seen) > trackClassTTL {
+			delete(tracks, id)
+		}
+	}
+
+	crossings := map[crossingKey]map[string]int64{}
+	var last *Frame
+	for i, frame := range data.LiveData.Frames {
+		if frame.TrackedObjects != nil {
+			last = &data.LiveData.Frames[i]
+		}
+		for _, object := range frame.TrackedObjects {
+			if class, ok := classify(object, config); ok {
+				tracks[object.TrackID] = trackClass{class: class, seen: now}
+			}
+		}
+		for _, event := range frame.Events {
+			if event.Category != "COUNT" || event.Attributes.TrackID == 0 {
+				continue
+			}
+			track, ok := tracks[event.Attributes.TrackID]
+			if !ok {
+				continue // not classified
+			}
+			var direction string
+			switch event.Attributes.CounterID % 1000 {
+			case 1:
+				direction = "forward"
+			case 2:
+				direction = "backward"
+			default:
+				continue
+			}
+			key := crossingKey{counterID: event.Attributes.CounterID, direction: direction}
+			if crossings[key] == nil {
+				crossings[key] = map[string]int64{}
+			}
+			crossings[key][track.class]++
+			if track.class == classAdult || track.class == classChild {
+				crossings[key][classVisitors]++
+			}
+		}
+	}
+
+	if last == nil {
+		return crossings, nil
+	}
+	zoneCounts := map[int]map[string]any{}
+	for logicID, polygons := range zones {
+		counts := map[string]int{}
+		for _, object := range last.TrackedObjects {
+			if len(object.Position) < 2 {
+				continue
+			}
+			class, ok := classify(object, config)
+			if !ok || !slices.ContainsFunc(polygons, func(polygon [][]float64) bool {
+				return insidePolygon(object.Position[0], object.Position[1], polygon)
+			}) {
+				continue
+			}
+			counts[class]++
+		}
+		classCounts := map[string]any{
+			classAdult:    counts[classAdult],
+			classChild:    counts[classChild],
+			classGroup:    counts[classGroup],
+			classStaff:    counts[classStaff],
+			classVisitors: counts[classAdult] + counts[classChild],
+		}
+		key := zoneKey{serialNumber: serialNumber, logicID: logicID}
+		if previous, ok := c.zoneCounts[key]; ok && maps.Equal(previous, classCounts) {
+			continue
+		}
+		c.zoneCounts[key] = classCounts
+		zoneCounts[logicID] = classCounts
+	}
+	return crossings, zoneCounts
+}
+
+// classify returns the class of the tracked object by the rules of the
+// configuration. Objects other than persons and groups and groups of fewer
+// members than required are not classified. Persons of unknown height are
+// adults unless tagged otherwise.
+func classify(object TrackedObject, config confmodel.Configuration) (string, bool) {
+	staffTag := config.StaffTag
+	if staffTag == "" {
+		staffTag = confmodel.DefaultStaffTag
+	}
+	childMaxHeight := config.ChildMaxHeight
+	if childMaxHeight <= 0 {
+		childMaxHeight = confmodel.DefaultChildMaxHeight
+	}
+	groupMinMembers := int(config.GroupMinMembers)
+	if groupMinMembers <= 0 {
+		groupMinMembers = confmodel.DefaultGroupMinMembers
+	}
+
+	tagged := func(tag string) bool {
+		return slices.ContainsFunc(object.Attributes.Tags, func(t string) bool { return strings.EqualFold(t, tag) })
+	}
+	switch strings.ToUpper(object.Type) {
+	case objectPerson:
+		if tagged(staffTag) {
+			return classStaff, true
+		}
+		height := object.Attributes.PersonHeight
+		if tagged(tagChild) || height > 0 && height < childMaxHeight {
+			return classChild, true
+		}
+		return classAdult, true
+	case objectGroup:
+		if object.Attributes.Members >= groupMinMembers {
+			return classGroup, true
+		}
+	}
+	return "", false
+}
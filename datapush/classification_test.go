@@ -0,0 +1,154 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package datapush
+
+import (
+	"maps"
+	"testing"
+	"time"
+	confmodel "xovis/model/conf"
+)
+
+func TestClassify(t *testing.T) {
+	person := func(height float64, tags ...string) TrackedObject {
+		object := TrackedObject{Type: "PERSON"}
+		object.Attributes.PersonHeight = height
+		object.Attributes.Tags = tags
+		return object
+	}
+	group := func(members int) TrackedObject {
+		object := TrackedObject{Type: "GROUP"}
+		object.Attributes.Members = members
+		return object
+	}
+	custom := confmodel.Configuration{StaffTag: "crew", ChildMaxHeight: 1.2, GroupMinMembers: 3}
+
+	tests := []struct {
+		name      string
+		object    TrackedObject
+		config    confmodel.Configuration
+		wantClass string // empty if not classified
+	}{
+		{name: "adult", object: person(1.75), wantClass: classAdult},
+		{name: "unknown height", object: person(0), wantClass: classAdult},
+		{name: "child", object: person(1.3), wantClass: classChild},
+		{name: "child max height", object: person(1.4), wantClass: classAdult},
+		{name: "child by tag", object: person(1.6, "Child"), wantClass: classChild},
+		{name: "child of custom height", object: person(1.3), config: custom, wantClass: classAdult},
+		{name: "staff", object: person(1.8, "staff"), wantClass: classStaff},
+		{name: "staff tag ignores case", object: person(1.8, "STAFF"), wantClass: classStaff},
+		{name: "staff before child", object: person(1.3, "staff"), wantClass: classStaff},
+		{name: "custom staff tag", object: person(1.8, "crew"), config: custom, wantClass: classStaff},
+		{name: "default staff tag with custom one", object: person(1.8, "staff"), config: custom, wantClass: classAdult},
+		{name: "group", object: group(2), wantClass: classGroup},
+		{name: "group too small", object: group(1)},
+		{name: "group of custom size", object: group(3), config: custom, wantClass: classGroup},
+		{name: "group too small for custom size", object: group(2), config: custom},
+		{name: "other object", object: TrackedObject{Type: "TROLLEY"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class, ok := classify(tt.object, tt.config)
+			if ok != (tt.wantClass != "") || class != tt.wantClass {
+				t.Errorf("classify() = %q, %v, want %q", class, ok, tt.wantClass)
+			}
+		})
+	}
+}
+
+func TestClassifierAddCrossings(t *testing.T) {
+	c := classifier{
+		zones:      map[string]map[int][][][]float64{},
+		tracks:     map[string]map[int]trackClass{},
+		zoneCounts: map[zoneKey]map[string]any{},
+	}
+	now := time.Now()
+
+	// The tracks are classified in the first push, the crossings of the second
+	// push are counted with their classes from then.
+	first := `{"live_data": {"frames": [{
+		"tracked_objects": [
+			{"track_id": 1, "type": "PERSON", "attributes": {"person_height": 1.8}},
+			{"track_id": 2, "type": "PERSON", "attributes": {"person_height": 1.2}},
+			{"track_id": 3, "type": "PERSON", "attributes": {"person_height": 1.7, "tags": ["staff"]}},
+			{"track_id": 4, "type": "GROUP", "attributes": {"members": 3}}
+		],
+		"events": [
+			{"category": "COUNT", "attributes": {"counter_id": 1008001, "track_id": 1}},
+			{"category": "COUNT", "attributes": {"counter_id": 1008002, "track_id": 2}},
+			{"category": "COUNT", "attributes": {"counter_id": 1008001, "track_id": 3}},
+			{"category": "COUNT", "attributes": {"counter_id": 1008001, "track_id": 4}},
+			{"category": "COUNT", "attributes": {"counter_id": 1008001, "track_id": 9}},
+			{"category": "COUNT", "attributes": {"counter_id": 1008003, "track_id": 1}},
+			{"category": "COUNT", "attributes": {"counter_id": 1008001}},
+			{"category": "ALERT", "attributes": {"counter_id": 1008001, "track_id": 1}}
+		]
+	}]}}`
+	second := `{"live_data": {"frames": [{
+		"events": [
+			{"category": "COUNT", "attributes": {"counter_id": 1008001, "track_id": 1}},
+			{"category": "COUNT", "attributes": {"counter_id": 1008001, "track_id": 2}},
+			{"category": "COUNT", "attributes": {"counter_id": 1009002, "track_id": 1}}
+		]
+	}]}}`
+	forward := crossingKey{counterID: 1008001, direction: "forward"}
+	backward := crossingKey{counterID: 1008002, direction: "backward"}
+	otherBackward := crossingKey{counterID: 1009002, direction: "backward"}
+
+	tests := []struct {
+		name string
+		push string
+		now  time.Time
+		want map[crossingKey]map[string]int64
+	}{
+		{
+			name: "classified in the push",
+			push: first,
+			now:  now,
+			want: map[crossingKey]map[string]int64{
+				forward:  {classAdult: 1, classStaff: 1, classGroup: 1, classVisitors: 1},
+				backward: {classChild: 1, classVisitors: 1},
+			},
+		},
+		{
+			name: "classified in an earlier push",
+			push: second,
+			now:  now.Add(trackClassTTL / 2),
+			want: map[crossingKey]map[string]int64{
+				forward:       {classAdult: 1, classChild: 1, classVisitors: 2},
+				otherBackward: {classAdult: 1, classVisitors: 1},
+			},
+		},
+		{
+			name: "class expired",
+			push: second,
+			now:  now.Add(trackClassTTL * 2),
+			want: map[crossingKey]map[string]int64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Decode("application/json", []byte(tt.push))
+			if err != nil {
+				t.Fatal(err)
+			}
+			crossings, _ := c.add("80:1F:12:D3:4C:5A", data, nil, confmodel.Configuration{}, tt.now)
+			if !maps.EqualFunc(crossings, tt.want, maps.Equal) {
+				t.Errorf("crossings %v, want %v", crossings, tt.want)
+			}
+		})
+	}
+}
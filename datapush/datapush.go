@@ -0,0 +1,349 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package datapush writes the data pushed by the sensors to Eliona, regardless
+// of whether it is received through the webhook or through MQTT.
+package datapush
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	"xovis/conf"
+	"xovis/eliona"
+	"xovis/metrics"
+	confmodel "xovis/model/conf"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+type Data struct {
+	LiveData struct {
+		PackageInfo struct {
+			Version string `json:"version" xml:"version"`
+			ID      int    `json:"id" xml:"id"`
+			AgentID int    `json:"agent_id" xml:"agent_id"`
+		} `json:"package_info" xml:"package_info"`
+		SensorInfo struct {
+			SerialNumber string `json:"serial_number" xml:"serial_number"`
+			Type         string `json:"type" xml:"type"`
+		} `json:"sensor_info" xml:"sensor_info"`
+		Config struct {
+			Logics []struct {
+				ID           int    `json:"id" xml:"id"`
+				Name         string `json:"name" xml:"name"`
+				OptionalData string `json:"optional_data" xml:"optional_data"`
+				Geometries   []int  `json:"geometries" xml:"geometries>geometry"`
+			} `json:"logics" xml:"logics>logic"`
+			Counts []struct {
+				ID      int    `json:"id" xml:"id"`
+				Name    string `json:"name" xml:"name"`
+				LogicID int    `json:"logic_id" xml:"logic_id"`
+				Type    string `json:"type" xml:"type"`
+			} `json:"counts" xml:"counts>count"`
+			Geometries []Geometry `json:"geometries" xml:"geometries>geometry"`
+		} `json:"config" xml:"config"`
+		Frames []Frame `json:"frames" xml:"frames>frame"`
+	} `json:"live_data" xml:"-"`
+
+	// LogicsData is set for packages of a logics push.
+	LogicsData *LogicsData `json:"logics_data" xml:"-"`
+
+	// StatusData is set for packages of a status push.
+	StatusData *StatusData `json:"status_data" xml:"-"`
+}
+
+// Geometry is a line or zone drawn on the sensor, in meters.
+type Geometry struct {
+	ID       int         `json:"id" xml:"id"`
+	Name     string      `json:"name" xml:"name"`
+	Type     string      `json:"type" xml:"type"`
+	Geometry [][]float64 `json:"geometry" xml:"-"`
+}
+
+type Frame struct {
+	FrameNumber  int    `json:"framenumber" xml:"framenumber"`
+	FrameType    string `json:"frametype" xml:"frametype"`
+	Time         int64  `json:"time" xml:"time"`
+	Illumination string `json:"illumination" xml:"illumination"`
+
+	// TrackedObjects is nil if the push does not include the tracked objects.
+	TrackedObjects []TrackedObject `json:"tracked_objects" xml:"-"`
+
+	Events []struct {
+		Category   string `json:"category" xml:"category"`
+		Type       string `json:"type" xml:"type"`
+		Attributes struct {
+			CounterID    int `json:"counter_id" xml:"counter_id"`
+			CounterValue int `json:"counter_value" xml:"counter_value"`
+			TrackID      int `json:"track_id" xml:"track_id"`
+		} `json:"attributes" xml:"attributes"`
+	} `json:"events" xml:"events>event"`
+}
+
+// TrackedObject is an object seen by the sensor in a frame.
+type TrackedObject struct {
+	TrackID    int       `json:"track_id" xml:"track_id"`
+	Type       string    `json:"type" xml:"type"`
+	Position   []float64 `json:"position" xml:"-"` // x, y and z in meters
+	Attributes struct {
+		PersonHeight float64  `json:"person_height" xml:"person_height"` // in meters
+		Members      int      `json:"members" xml:"members"`
+		Tags         []string `json:"tags" xml:"tags>tag"` // e.g. staff, as set up on the sensor
+	} `json:"attributes" xml:"attributes"`
+}
+
+// Decode parses a live data, logics or status push as sent by the sensors, in
+// JSON or in XML. The format is told by the content type, or by the body if
+// the content type is neither, e.g. for MQTT messages.
+func Decode(contentType string, body []byte) (Data, error) {
+	log.Trace("datapush", "raw datapush:\n%s\n", string(body))
+
+	var data Data
+	if isXML(contentType, body) {
+		if err := decodeXML(body, &data); err != nil {
+			return Data{}, fmt.Errorf("parsing XML datapush: %w", err)
+		}
+		return data, nil
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return Data{}, fmt.Errorf("parsing datapush: %w", err)
+	}
+	return data, nil
+}
+
+// run tells how a package is processed.
+type run struct {
+	// retry is set if the package was processed before, so that its tracked
+	// objects and their crossings per class, which are accumulated, were
+	// counted already.
+	retry bool
+
+	// replay is the configuration an archived package is replayed against.
+	// Only the counts of lines and zones are written then, to the assets of
+	// the configuration with the time of their frame or bin. The state kept
+	// of the sensor, like the last frames of its counters, is left as it is.
+	replay *confmodel.Configuration
+
+	// upserts records the data instead of writing it, if set.
+	upserts *[]Upsert
+}
+
+// process writes the events, geometries and tracked objects of a live data
+// push with their counts per class, the bin records of a logics push and the
+// device events of a status push to the sensor and its assets. Data which
+// cannot be written is logged and counted as dropped, like counts of frames
+// older than the last one written to their counter. The returned error tells
+// that data may be written by processing the package again, which writes its
+// counts again.
+func process(ctx context.Context, data Data, r run) error {
+	err := processLiveData(ctx, r, data)
+	if data.LogicsData != nil {
+		err = errors.Join(err, processLogicsData(ctx, r, *data.LogicsData))
+	}
+	if r.replay != nil {
+		return err
+	}
+	processGeometries(ctx, data)
+	if !r.retry {
+		processTrackedObjects(ctx, data)
+		processClassification(ctx, data)
+	}
+	if data.StatusData != nil {
+		processStatusData(ctx, *data.StatusData)
+	}
+	return err
+}
+
+func processLiveData(ctx context.Context, r run, data Data) error {
+	var failed failures
+	for _, frame := range data.LiveData.Frames {
+		// Only the last count of a counter in the frame is written, as the
+		// earlier ones are stale once it is.
+		lastCount := map[int]int{}
+		for i, event := range frame.Events {
+			if event.Category == "COUNT" {
+				lastCount[event.Attributes.CounterID] = i
+			}
+		}
+		for i, event := range frame.Events {
+			switch event.Category {
+			case "COUNT":
+				counterValue := event.Attributes.CounterValue
+				rawCounterID := event.Attributes.CounterID
+				if lastCount[rawCounterID] != i {
+					continue
+				}
+				logicID := rawCounterID / 1000   // Get the first part (e.g., 1008 from 1008001)
+				counterID := rawCounterID % 1000 // Get the last part (e.g., 001 from 1008001)
+
+				asset, kind, err := r.logicAsset(ctx, data.LiveData.SensorInfo.SerialNumber, logicID)
+				if err != nil {
+					failed.add(dropLookup(err))
+					continue
+				}
+
+				dataToUpsert := map[string]any{"presence": counterValue}
+				if kind == logicLine {
+					// Determine the key based on counterID (001 -> "forward", 002 -> "backward")
+					var key string
+					switch counterID {
+					case 1:
+						key = "forward"
+					case 2:
+						key = "backward"
+					default:
+						log.Warn("datapush", "unknown counter ID %v for logic %v, skipping", counterID, logicID)
+						metrics.DatapushEventsDropped.WithLabelValues(metrics.DropUnknownCounter).Inc()
+						continue
+					}
+
+					dataToUpsert = map[string]any{key: counterValue}
+				}
+				if r.replay == nil && staleFrame(ctx, data.LiveData.SensorInfo.SerialNumber, rawCounterID, frame) {
+					log.Debug("datapush", "skipping count of frame %d of counter %d, a later frame was written", frame.FrameNumber, rawCounterID)
+					metrics.DatapushEventsDropped.WithLabelValues(metrics.DropStale).Inc()
+					continue
+				}
+				var timestamp time.Time
+				if r.replay != nil && frame.Time > 0 {
+					timestamp = time.UnixMilli(frame.Time)
+				}
+				if err := r.upsert(ctx, asset, dataToUpsert, timestamp); err != nil {
+					log.Error("datapush", "upserting data: %v", err)
+					metrics.DatapushEventsDropped.WithLabelValues(metrics.DropUpsertFailed).Inc()
+					failed.add(fmt.Errorf("upserting data of asset %d: %w", asset.AssetID, err))
+					continue
+				}
+				log.Debug("datapush", "set %v data %+v", asset.AssetID, dataToUpsert)
+				metrics.DatapushEventsProcessed.WithLabelValues(event.Category).Inc()
+			}
+		}
+	}
+
+	// The illumination of the last frame is the current one, which is not
+	// known for replayed packages.
+	if r.replay != nil {
+		return failed.err()
+	}
+	frames := data.LiveData.Frames
+	for i := len(frames) - 1; i >= 0; i-- {
+		if frames[i].Illumination != "" {
+			var timestamp time.Time
+			if frames[i].Time > 0 {
+				timestamp = time.UnixMilli(frames[i].Time)
+			}
+			updateIllumination(ctx, data.LiveData.SensorInfo.SerialNumber, frames[i].Illumination, timestamp)
+			break
+		}
+	}
+	return failed.err()
+}
+
+// Kinds of logics with an asset.
+const (
+	logicZone = "zone"
+	logicLine = "line"
+)
+
+// logicAsset returns the asset of the logic of the sensor and whether it is a
+// zone or a line.
+func (r run) logicAsset(ctx context.Context, serialNumber string, logicID int) (confmodel.Asset, string, error) {
+	gai := fmt.Sprintf("xovis_zone_%v_%v", serialNumber, logicID)
+	asset, err := r.asset(ctx, gai)
+	if err == nil {
+		return asset, logicZone, nil
+	}
+
+	// Looks like there is no better way now to distinguish lines and zones...
+	if errors.Is(err, conf.ErrNotFound) {
+		gai = fmt.Sprintf("xovis_line_%v_%v", serialNumber, logicID)
+		asset, err = r.asset(ctx, gai)
+		if err == nil {
+			return asset, logicLine, nil
+		}
+	}
+	return confmodel.Asset{}, "", fmt.Errorf("getting asset by GAI %s: %w", gai, err)
+}
+
+func (r run) asset(ctx context.Context, gai string) (confmodel.Asset, error) {
+	if r.replay != nil {
+		return conf.GetConfigAssetByGAI(ctx, *r.replay, gai)
+	}
+	return conf.GetAssetByGAI(gai)
+}
+
+// peopleCounterAsset returns the people counter asset of the sensor. It
+// returns false if there is none yet, as it is created by the next collection.
+func peopleCounterAsset(serialNumber string) (confmodel.Asset, bool, error) {
+	gai := "xovis_people_counter_" + serialNumber
+	asset, err := conf.GetAssetByGAI(gai)
+	if errors.Is(err, conf.ErrNotFound) {
+		return confmodel.Asset{}, false, nil
+	}
+	if err != nil {
+		return confmodel.Asset{}, false, fmt.Errorf("getting asset by GAI %s: %w", gai, err)
+	}
+	return asset, true, nil
+}
+
+// upsert writes the input data to the asset, or records it for a dry run.
+func (r run) upsert(ctx context.Context, asset confmodel.Asset, data map[string]any, timestamp time.Time) error {
+	if r.upserts != nil {
+		*r.upserts = append(*r.upserts, Upsert{
+			AssetID:   asset.AssetID,
+			GAI:       asset.GlobalAssetID,
+			Data:      data,
+			Timestamp: timestamp,
+		})
+		return nil
+	}
+	return eliona.UpsertAssetDataAt(ctx, asset.Config, asset.AssetID, data, timestamp)
+}
+
+// dropLookup records an event dropped because its asset could not be found.
+// It returns the error if the asset could not be looked up, which may succeed
+// later, and nil if the asset does not exist.
+func dropLookup(err error) error {
+	log.Error("datapush", "%v", err)
+	if errors.Is(err, conf.ErrNotFound) {
+		metrics.DatapushEventsDropped.WithLabelValues(metrics.DropUnknownAsset).Inc()
+		return nil
+	}
+	metrics.DatapushEventsDropped.WithLabelValues(metrics.DropLookupFailed).Inc()
+	return err
+}
+
+// failures collects the errors of data which could not be written.
+type failures []error
+
+func (f *failures) add(err error) {
+	if err != nil {
+		*f = append(*f, err)
+	}
+}
+
+// err returns the first error, as the others are usually alike.
+func (f failures) err() error {
+	switch len(f) {
+	case 0:
+		return nil
+	case 1:
+		return f[0]
+	}
+	return fmt.Errorf("%w (and %d more failures)", f[0], len(f)-1)
+}
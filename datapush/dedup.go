@@ -0,0 +1,98 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package datapush
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+	"xovis/conf"
+	"xovis/metrics"
+	confmodel "xovis/model/conf"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// Processed packages are remembered for packageRetention, which is checked
+// every pruneInterval.
+const (
+	packageRetention = 24 * time.Hour
+	pruneInterval    = time.Hour
+)
+
+// lastPrune is the Unix time of the last removal of old packages.
+var lastPrune atomic.Int64
+
+// identifyPackage returns the identity of the live data package, by which
+// packages pushed again, e.g. as the sensor retried a push, are recognized.
+// Packages without ID or frames are not identified.
+func identifyPackage(data Data) *confmodel.DatapushPackage {
+	live := data.LiveData
+	info := live.PackageInfo
+	if live.SensorInfo.SerialNumber == "" || len(live.Frames) == 0 || info.ID == 0 && info.AgentID == 0 {
+		return nil
+	}
+	return &confmodel.DatapushPackage{
+		SerialNumber: live.SensorInfo.SerialNumber,
+		AgentID:      int32(info.AgentID),
+		PackageID:    int64(info.ID),
+		FirstFrame:   int64(live.Frames[0].FrameNumber),
+	}
+}
+
+// skipDuplicate records the live data package as skipped, as it was received
+// before.
+func skipDuplicate(data Data) {
+	live := data.LiveData
+	log.Debug("datapush", "skipping package %d of agent %d of sensor %s, received before", live.PackageInfo.ID, live.PackageInfo.AgentID, live.SensorInfo.SerialNumber)
+	metrics.DatapushDuplicatePackages.Inc()
+	for _, frame := range live.Frames {
+		for _, event := range frame.Events {
+			if event.Category == "COUNT" {
+				metrics.DatapushEventsDropped.WithLabelValues(metrics.DropDuplicate).Inc()
+			}
+		}
+	}
+}
+
+// staleFrame tells whether a later frame than the given one was written to
+// the counter before. Otherwise, the frame is recorded as the last one of the
+// counter. If the counter cannot be checked, the frame is written. A frame is
+// not stale for itself, so that a retry writes its count again.
+func staleFrame(ctx context.Context, serialNumber string, counterID int, frame Frame) bool {
+	claimed, err := conf.ClaimCounterFrame(ctx, serialNumber, int32(counterID), frame.Time, int64(frame.FrameNumber))
+	if err != nil {
+		log.Error("datapush", "%v", err)
+		return false
+	}
+	return !claimed
+}
+
+// pruneDatapushPackages removes the packages older than packageRetention, at
+// most every pruneInterval.
+func pruneDatapushPackages(ctx context.Context) {
+	now := time.Now()
+	last := lastPrune.Load()
+	if now.Sub(time.Unix(last, 0)) < pruneInterval || !lastPrune.CompareAndSwap(last, now.Unix()) {
+		return
+	}
+	deleted, err := conf.DeleteDatapushPackages(ctx, now.Add(-packageRetention))
+	if err != nil {
+		log.Error("datapush", "%v", err)
+		return
+	}
+	log.Debug("datapush", "removed %d processed packages", deleted)
+}
@@ -0,0 +1,95 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package datapush
+
+import (
+	"testing"
+	confmodel "xovis/model/conf"
+)
+
+func TestIdentifyPackage(t *testing.T) {
+	tests := []struct {
+		name string
+		push string
+		want *confmodel.DatapushPackage
+	}{
+		{
+			name: "live data",
+			push: `{"live_data": {
+				"package_info": {"id": 4711, "agent_id": 2},
+				"sensor_info": {"serial_number": "80:1F:12:D3:4C:5A"},
+				"frames": [{"framenumber": 120384}, {"framenumber": 120385}]
+			}}`,
+			want: &confmodel.DatapushPackage{SerialNumber: "80:1F:12:D3:4C:5A", AgentID: 2, PackageID: 4711, FirstFrame: 120384},
+		},
+		{
+			name: "agent without package ID",
+			push: `{"live_data": {
+				"package_info": {"agent_id": 2},
+				"sensor_info": {"serial_number": "80:1F:12:D3:4C:5A"},
+				"frames": [{"framenumber": 120384}]
+			}}`,
+			want: &confmodel.DatapushPackage{SerialNumber: "80:1F:12:D3:4C:5A", AgentID: 2, FirstFrame: 120384},
+		},
+		{
+			name: "no package info",
+			push: `{"live_data": {
+				"sensor_info": {"serial_number": "80:1F:12:D3:4C:5A"},
+				"frames": [{"framenumber": 120384}]
+			}}`,
+		},
+		{
+			name: "no sensor",
+			push: `{"live_data": {
+				"package_info": {"id": 4711, "agent_id": 2},
+				"frames": [{"framenumber": 120384}]
+			}}`,
+		},
+		{
+			name: "no frames",
+			push: `{"live_data": {
+				"package_info": {"id": 4711, "agent_id": 2},
+				"sensor_info": {"serial_number": "80:1F:12:D3:4C:5A"},
+				"frames": []
+			}}`,
+		},
+		{
+			name: "logics push",
+			push: `{"logics_data": {
+				"package_info": {"id": 815, "agent_id": 3},
+				"sensor_info": {"serial_number": "80:1F:12:D3:4C:5A"}
+			}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Decode("application/json", []byte(tt.push))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := identifyPackage(data)
+			if got == nil || tt.want == nil {
+				if got != tt.want {
+					t.Errorf("identifyPackage() = %+v, want %+v", got, tt.want)
+				}
+				return
+			}
+			if *got != *tt.want {
+				t.Errorf("identifyPackage() = %+v, want %+v", *got, *tt.want)
+			}
+		})
+	}
+}
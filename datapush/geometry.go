@@ -0,0 +1,85 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package datapush
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+	"xovis/conf"
+	confmodel "xovis/model/conf"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// storedGeometries holds the encoded geometries last stored per sensor, so
+// that the configuration repeated in every live push is only stored when it
+// changes.
+var storedGeometries sync.Map
+
+// processGeometries stores the points of the geometries of the logics in the
+// configuration of a live push. Geometries of no logic are skipped.
+func processGeometries(ctx context.Context, data Data) {
+	live := data.LiveData
+	serialNumber := live.SensorInfo.SerialNumber
+	if serialNumber == "" || len(live.Config.Geometries) == 0 {
+		return
+	}
+	byID := make(map[int]Geometry, len(live.Config.Geometries))
+	for _, geometry := range live.Config.Geometries {
+		byID[geometry.ID] = geometry
+	}
+	var geometries []confmodel.Geometry
+	for _, logic := range live.Config.Logics {
+		for _, id := range logic.Geometries {
+			geometry, ok := byID[id]
+			if !ok {
+				continue
+			}
+			geometries = append(geometries, confmodel.Geometry{
+				SerialNumber: serialNumber,
+				LogicID:      int32(logic.ID),
+				GeometryID:   int32(geometry.ID),
+				Name:         geometry.Name,
+				Type:         geometry.Type,
+				Points:       geometry.Geometry,
+			})
+		}
+	}
+	if len(geometries) == 0 {
+		return
+	}
+
+	encoded, err := json.Marshal(geometries)
+	if err != nil {
+		log.Error("datapush", "encoding geometries of sensor %s: %v", serialNumber, err)
+		return
+	}
+	if stored, ok := storedGeometries.Load(serialNumber); ok && stored.(string) == string(encoded) {
+		return
+	}
+	now := time.Now()
+	for i := range geometries {
+		geometries[i].UpdatedAt = now
+	}
+	if err := conf.UpsertGeometries(ctx, geometries); err != nil {
+		log.Error("datapush", "storing geometries of sensor %s: %v", serialNumber, err)
+		return
+	}
+	storedGeometries.Store(serialNumber, string(encoded))
+	log.Debug("datapush", "stored %d geometries of sensor %s", len(geometries), serialNumber)
+}
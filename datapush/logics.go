@@ -0,0 +1,181 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package datapush
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"xovis/metrics"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// LogicsData is a package of a logics push, with the counts of the logics
+// aggregated per time bin.
+type LogicsData struct {
+	PackageInfo struct {
+		Version string `json:"version" xml:"version"`
+		ID      int    `json:"id" xml:"id"`
+		AgentID int    `json:"agent_id" xml:"agent_id"`
+	} `json:"package_info" xml:"package_info"`
+	SensorInfo struct {
+		SerialNumber string `json:"serial_number" xml:"serial_number"`
+		Type         string `json:"type" xml:"type"`
+	} `json:"sensor_info" xml:"sensor_info"`
+	Logics []Logic `json:"logics" xml:"logics>logic"`
+}
+
+type Logic struct {
+	ID      int           `json:"id" xml:"id"`
+	Name    string        `json:"name" xml:"name"`
+	Info    string        `json:"info" xml:"info"`
+	Records []LogicRecord `json:"records" xml:"records>record"`
+}
+
+// LogicRecord holds the counts of a logic in the time bin from From to To.
+type LogicRecord struct {
+	From    BinTime      `json:"from" xml:"from"`
+	To      BinTime      `json:"to" xml:"to"`
+	Samples int          `json:"samples" xml:"samples"`
+	Counts  []LogicCount `json:"counts" xml:"counts>count"`
+}
+
+type LogicCount struct {
+	ID    int    `json:"id" xml:"id"`
+	Name  string `json:"name" xml:"name"`
+	Value int    `json:"value" xml:"value"`
+}
+
+// BinTime is a bin boundary, sent as Unix time in milliseconds or seconds or as
+// ISO 8601 string depending on the time format of the push agent.
+type BinTime struct {
+	time.Time
+}
+
+// unixMillisThreshold separates Unix times in seconds from ones in
+// milliseconds, which are larger from 1973 on.
+const unixMillisThreshold = 100_000_000_000
+
+func (t *BinTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		parsed, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return fmt.Errorf("parsing bin time %q: %w", s, err)
+		}
+		t.Time = parsed
+		return nil
+	}
+	unix, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("parsing bin time %s: %w", data, err)
+	}
+	t.setUnix(unix)
+	return nil
+}
+
+// UnmarshalText parses the bin time of XML packages, which is the text of its
+// element. It overrides the one of time.Time, which only reads ISO 8601.
+func (t *BinTime) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	if s == "" {
+		return nil
+	}
+	if unix, err := strconv.ParseInt(s, 10, 64); err == nil {
+		t.setUnix(unix)
+		return nil
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return fmt.Errorf("parsing bin time %q: %w", s, err)
+	}
+	t.Time = parsed
+	return nil
+}
+
+func (t *BinTime) setUnix(unix int64) {
+	if unix < unixMillisThreshold {
+		t.Time = time.Unix(unix, 0)
+	} else {
+		t.Time = time.UnixMilli(unix)
+	}
+}
+
+// Attributes the counts of logics push records are written to. Zone balances
+// are occupancies like the live counts, the line counts are per bin.
+var logicsAttributes = map[string]map[string]string{
+	logicZone: {
+		"balance": "presence",
+	},
+	logicLine: {
+		"fw": "forward_bin",
+		"bw": "backward_bin",
+	},
+}
+
+const categoryLogics = "LOGICS"
+
+// processLogicsData writes the counts of each bin record with the end of the
+// bin as timestamp.
+func processLogicsData(ctx context.Context, r run, data LogicsData) error {
+	var failed failures
+	for _, logic := range data.Logics {
+		if len(logic.Records) == 0 {
+			continue
+		}
+		asset, kind, err := r.logicAsset(ctx, data.SensorInfo.SerialNumber, logic.ID)
+		if err != nil {
+			failed.add(dropLookup(err))
+			continue
+		}
+		attributes := logicsAttributes[kind]
+
+		for _, record := range logic.Records {
+			dataToUpsert := map[string]any{}
+			for _, count := range record.Counts {
+				attribute, ok := attributes[count.Name]
+				if !ok {
+					log.Debug("datapush", "unknown count %q of %s logic %v, skipping", count.Name, kind, logic.ID)
+					metrics.DatapushEventsDropped.WithLabelValues(metrics.DropUnknownCounter).Inc()
+					continue
+				}
+				dataToUpsert[attribute] = count.Value
+			}
+			if len(dataToUpsert) == 0 {
+				continue
+			}
+			if err := r.upsert(ctx, asset, dataToUpsert, record.To.Time); err != nil {
+				log.Error("datapush", "upserting data: %v", err)
+				metrics.DatapushEventsDropped.WithLabelValues(metrics.DropUpsertFailed).Inc()
+				failed.add(fmt.Errorf("upserting data of asset %d: %w", asset.AssetID, err))
+				continue
+			}
+			log.Debug("datapush", "set %v data %+v for bin %v to %v", asset.AssetID, dataToUpsert, record.From.Time, record.To.Time)
+			metrics.DatapushEventsProcessed.WithLabelValues(categoryLogics).Inc()
+		}
+	}
+	return failed.err()
+}
@@ -0,0 +1,194 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package datapush
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+	"xovis/conf"
+	"xovis/metrics"
+	confmodel "xovis/model/conf"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// A failed package is retried after retryDelay, doubled with each attempt up
+// to maxRetryDelay, and moved to the dead letters after maxAttempts, which
+// covers an outage of the Eliona API of about 20 minutes.
+const (
+	maxAttempts   = 8
+	retryDelay    = 10 * time.Second
+	maxRetryDelay = 10 * time.Minute
+)
+
+// A package is processed within processTimeout, which stays below the
+// shutdown timeout of the app, and locked for the lease meanwhile. The queue
+// is checked every pollInterval for packages queued by other instances and
+// for retries.
+const (
+	processTimeout = 15 * time.Second
+	lease          = time.Minute
+	pollInterval   = time.Second
+)
+
+// queued wakes a worker when a package was queued by this instance.
+var queued = make(chan struct{}, 1)
+
+// Enqueue adds the decoded datapush package received for the configuration to
+// the queue, from which the workers process it. Live data packages received
+// before are skipped. If an error is returned, the package was not queued and
+// should be pushed again.
+func Enqueue(ctx context.Context, source string, configID int64, data Data) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("encoding datapush package: %v", err)
+	}
+	pkg := identifyPackage(data)
+	if pkg != nil {
+		pruneDatapushPackages(ctx)
+	}
+
+	enqueued, err := conf.EnqueueDatapush(ctx, confmodel.QueuedDatapush{
+		ConfigurationID: configID,
+		Source:          source,
+		SerialNumber:    data.SerialNumber(),
+		Payload:         payload,
+	}, pkg)
+	if err != nil {
+		return err
+	}
+	if !enqueued {
+		skipDuplicate(data)
+		return nil
+	}
+	metrics.DatapushQueued.Inc()
+	select {
+	case queued <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// SerialNumber returns the serial number of the sensor which pushed the package.
+func (data Data) SerialNumber() string {
+	switch {
+	case data.LiveData.SensorInfo.SerialNumber != "":
+		return data.LiveData.SensorInfo.SerialNumber
+	case data.LogicsData != nil:
+		return data.LogicsData.SensorInfo.SerialNumber
+	case data.StatusData != nil:
+		return data.StatusData.SensorInfo.SerialNumber
+	}
+	return ""
+}
+
+// RunWorkers processes the queued packages with the given number of workers
+// until the context is done. Packages being processed are finished and the
+// open heatmaps are stored before it returns.
+func RunWorkers(ctx context.Context, workers int) {
+	log.Info("datapush", "Starting %d datapush workers.", workers)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			work(ctx)
+		}()
+	}
+	wg.Wait()
+
+	storeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), heatmapStoreTimeout)
+	defer cancel()
+	storeHeatmaps(storeCtx, tracking.closeHeatmaps())
+}
+
+func work(ctx context.Context) {
+	for ctx.Err() == nil {
+		if processNext(ctx) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+		case <-queued:
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// processNext processes the next due package of the queue. It returns false
+// if there was none.
+func processNext(ctx context.Context) bool {
+	item, err := conf.ClaimQueuedDatapush(ctx, lease)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Error("datapush", "%v", err)
+		}
+		return false
+	}
+	if item == nil {
+		return false
+	}
+
+	// The package is finished on shutdown, as it is not pushed again.
+	processCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), processTimeout)
+	defer cancel()
+
+	var data Data
+	if err := json.Unmarshal(item.Payload, &data); err != nil {
+		deadLetter(processCtx, *item, fmt.Errorf("decoding queued package: %v", err), false)
+		return true
+	}
+	// A package claimed again after its lease expired may have been processed
+	// by the worker that lost it.
+	err = process(processCtx, data, run{retry: item.SideEffectsApplied || item.Attempts > 1})
+	switch {
+	case err == nil:
+		if err := conf.CompleteQueuedDatapush(processCtx, item.ID); err != nil {
+			log.Error("datapush", "%v", err)
+		}
+		metrics.DatapushQueueResults.WithLabelValues(metrics.QueueProcessed).Inc()
+	case item.Attempts >= maxAttempts:
+		deadLetter(processCtx, *item, err, true)
+	default:
+		delay := backoff(item.Attempts)
+		log.Warn("datapush", "Processing package %d of sensor %s failed in attempt %d, retrying in %v: %v", item.ID, item.SerialNumber, item.Attempts, delay, err)
+		if err := conf.RetryQueuedDatapush(processCtx, item.ID, time.Now().Add(delay), err.Error()); err != nil {
+			log.Error("datapush", "%v", err)
+		}
+		metrics.DatapushQueueResults.WithLabelValues(metrics.QueueRetried).Inc()
+	}
+	return true
+}
+
+func deadLetter(ctx context.Context, item confmodel.QueuedDatapush, err error, processed bool) {
+	log.Error("datapush", "Processing package %d of sensor %s failed in attempt %d, moving it to the dead letters: %v", item.ID, item.SerialNumber, item.Attempts, err)
+	if err := conf.DeadLetterQueuedDatapush(ctx, item.ID, err.Error(), processed); err != nil {
+		log.Error("datapush", "%v", err)
+	}
+	metrics.DatapushQueueResults.WithLabelValues(metrics.QueueDeadLettered).Inc()
+}
+
+// backoff returns the delay before the next attempt after the given attempts.
+func backoff(attempts int32) time.Duration {
+	delay := retryDelay
+	for i := int32(1); i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
@@ -0,0 +1,79 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package datapush
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"xovis/conf"
+	confmodel "xovis/model/conf"
+)
+
+// MaxReplayPackages limits the archived packages replayed at once.
+const MaxReplayPackages = 1000
+
+// Upsert is input data of an asset as a dry run would have written it. A zero
+// timestamp stands for the time of writing.
+type Upsert struct {
+	AssetID   int32          `json:"assetId"`
+	GAI       string         `json:"gai"`
+	Data      map[string]any `json:"data"`
+	Timestamp time.Time      `json:"timestamp,omitzero"`
+}
+
+// ReplayResult tells how many archived packages were replayed and why the
+// failed ones failed. For dry runs, it lists the data not written.
+type ReplayResult struct {
+	Packages int      `json:"packages"`
+	Failed   int      `json:"failed"`
+	Errors   []string `json:"errors"`
+	Upserts  []Upsert `json:"upserts,omitempty"`
+}
+
+// ReplayArchive processes the archived packages selected by the filter again,
+// the oldest first and at most MaxReplayPackages. The counts of lines and
+// zones are written to the assets of the configuration, with the time of
+// their frame or bin. Status events, geometries and tracked objects are
+// skipped, as are the checks for packages and frames written before. With
+// dryRun, the data is returned instead of written.
+func ReplayArchive(ctx context.Context, config confmodel.Configuration, filter confmodel.ArchiveFilter, dryRun bool) (ReplayResult, error) {
+	if filter.Limit == 0 || filter.Limit > MaxReplayPackages {
+		filter.Limit = MaxReplayPackages
+	}
+	packages, err := conf.GetArchivedDatapushes(ctx, filter, true)
+	if err != nil {
+		return ReplayResult{}, err
+	}
+
+	result := ReplayResult{Errors: []string{}}
+	r := run{replay: &config}
+	if dryRun {
+		r.upserts = &result.Upserts
+	}
+	for _, pkg := range packages {
+		result.Packages++
+		data, err := Decode(pkg.ContentType, pkg.Body)
+		if err == nil {
+			err = process(ctx, data, r)
+		}
+		if err != nil {
+			result.Failed++
+			result.Errors = append(result.Errors, fmt.Sprintf("package %d: %v", pkg.ID, err))
+		}
+	}
+	return result, nil
+}
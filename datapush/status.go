@@ -0,0 +1,227 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package datapush
+
+import (
+	"context"
+	"maps"
+	"time"
+	"xovis/conf"
+	"xovis/eliona"
+	"xovis/metrics"
+	confmodel "xovis/model/conf"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// StatusData is a package of a status push with the device events of the
+// sensor.
+type StatusData struct {
+	PackageInfo struct {
+		Version string `json:"version" xml:"version"`
+		ID      int    `json:"id" xml:"id"`
+		AgentID int    `json:"agent_id" xml:"agent_id"`
+	} `json:"package_info" xml:"package_info"`
+	SensorInfo struct {
+		SerialNumber string `json:"serial_number" xml:"serial_number"`
+		Type         string `json:"type" xml:"type"`
+	} `json:"sensor_info" xml:"sensor_info"`
+	Events []StatusEvent `json:"events" xml:"events>event"`
+}
+
+// StatusEvent reports a change of the device state. Alerts are raised with
+// state ACTIVE and cleared with any other state, illumination events carry the
+// illumination level as state.
+type StatusEvent struct {
+	Time    BinTime `json:"time" xml:"time"`
+	Type    string  `json:"type" xml:"type"`
+	State   string  `json:"state" xml:"state"`
+	Message string  `json:"message" xml:"message"`
+}
+
+// Types of status events.
+const (
+	statusOnline       = "DEVICE_ONLINE"
+	statusOffline      = "DEVICE_OFFLINE"
+	statusIllumination = "ILLUMINATION"
+	statusTilt         = "TILT"
+	statusCovered      = "CAMERA_COVERED"
+
+	stateActive = "ACTIVE"
+)
+
+const categoryStatus = "STATUS"
+
+// healthAlarmRules raise alarms for the health attributes of people counters
+// which make the counts wrong.
+var healthAlarmRules = []api.AlarmRule{
+	{
+		Subtype:             api.SUBTYPE_STATUS,
+		Attribute:           "online",
+		Enable:              common.Ptr(true),
+		Priority:            api.ALARM_PRIORITY_HEIGHT,
+		RequiresAcknowledge: common.Ptr(true),
+		Equal:               *api.NewNullableFloat64(common.Ptr(0.0)),
+		Message:             map[string]any{"en": "Xovis sensor is offline", "de": "Xovis-Sensor ist offline"},
+	},
+	{
+		Subtype:             api.SUBTYPE_STATUS,
+		Attribute:           "covered",
+		Enable:              common.Ptr(true),
+		Priority:            api.ALARM_PRIORITY_HEIGHT,
+		RequiresAcknowledge: common.Ptr(true),
+		Equal:               *api.NewNullableFloat64(common.Ptr(1.0)),
+		Message:             map[string]any{"en": "Camera of the Xovis sensor is covered", "de": "Kamera des Xovis-Sensors ist abgedeckt"},
+	},
+	{
+		Subtype:             api.SUBTYPE_STATUS,
+		Attribute:           "tilted",
+		Enable:              common.Ptr(true),
+		Priority:            api.ALARM_PRIORITY_MEDIUM,
+		RequiresAcknowledge: common.Ptr(true),
+		Equal:               *api.NewNullableFloat64(common.Ptr(1.0)),
+		Message:             map[string]any{"en": "Xovis sensor is tilted", "de": "Xovis-Sensor ist verkippt"},
+	},
+}
+
+func processStatusData(ctx context.Context, data StatusData) {
+	var latest time.Time
+	updateHealth(ctx, data.SensorInfo.SerialNumber, func(health *confmodel.SensorHealth) {
+		for _, event := range data.Events {
+			if !applyStatusEvent(health, event) {
+				log.Debug("datapush", "unknown status event %q of sensor %s, skipping", event.Type, data.SensorInfo.SerialNumber)
+				metrics.DatapushEventsDropped.WithLabelValues(metrics.DropUnknownStatus).Inc()
+				continue
+			}
+			if event.Time.After(latest) {
+				latest = event.Time.Time
+			}
+			if event.Message != "" {
+				log.Info("datapush", "sensor %s reported %s %s: %s", data.SensorInfo.SerialNumber, event.Type, event.State, event.Message)
+			}
+			metrics.DatapushEventsProcessed.WithLabelValues(categoryStatus).Inc()
+		}
+	}, func() time.Time { return latest })
+}
+
+// applyStatusEvent updates the health by the event. It returns false for
+// unknown events.
+func applyStatusEvent(health *confmodel.SensorHealth, event StatusEvent) bool {
+	switch event.Type {
+	case statusOnline:
+		health.Online = common.Ptr(true)
+	case statusOffline:
+		health.Online = common.Ptr(false)
+	case statusIllumination:
+		health.Illumination = common.Ptr(event.State)
+	case statusTilt:
+		health.Tilted = event.State == stateActive
+	case statusCovered:
+		health.Covered = event.State == stateActive
+	default:
+		return false
+	}
+	return true
+}
+
+// updateIllumination records the illumination reported with a live frame.
+func updateIllumination(ctx context.Context, serialNumber string, illumination string, timestamp time.Time) {
+	updateHealth(ctx, serialNumber, func(health *confmodel.SensorHealth) {
+		health.Illumination = &illumination
+	}, func() time.Time { return timestamp })
+}
+
+// updateHealth applies the changes to the stored health of the sensor. Changed
+// health is stored and written to the people counter at the time returned by
+// timestamp, a zero time standing for now.
+func updateHealth(ctx context.Context, serialNumber string, apply func(health *confmodel.SensorHealth), timestamp func() time.Time) {
+	if serialNumber == "" {
+		return
+	}
+	health, err := conf.GetSensorHealth(ctx, serialNumber)
+	if err != nil {
+		log.Error("datapush", "getting health of sensor %s: %v", serialNumber, err)
+		return
+	}
+	before := healthData(health)
+	apply(&health)
+	after := healthData(health)
+	if maps.Equal(before, after) && !health.UpdatedAt.IsZero() {
+		return
+	}
+
+	health.UpdatedAt = timestamp()
+	if health.UpdatedAt.IsZero() {
+		health.UpdatedAt = time.Now()
+	}
+	if err := conf.UpsertSensorHealth(ctx, health); err != nil {
+		log.Error("datapush", "storing health of sensor %s: %v", serialNumber, err)
+		return
+	}
+	if err := writeHealth(ctx, health, after); err != nil {
+		log.Error("datapush", "writing health of sensor %s: %v", serialNumber, err)
+	}
+}
+
+// writeHealth writes the health attributes to the people counter of the
+// sensor and creates the alarm rules for them if enabled.
+func writeHealth(ctx context.Context, health confmodel.SensorHealth, data map[string]any) error {
+	asset, ok, err := peopleCounterAsset(health.SerialNumber)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// Its health is written with the next change.
+		log.Debug("datapush", "no people counter for sensor %s yet, health not written", health.SerialNumber)
+		return nil
+	}
+	if err := eliona.UpsertAssetStatusAt(ctx, asset.AssetID, data, health.UpdatedAt); err != nil {
+		return err
+	}
+	log.Debug("datapush", "set %v status %+v", asset.AssetID, data)
+	if asset.Config.HealthAlarms {
+		if err := eliona.EnsureAlarmRules(ctx, asset.AssetID, healthAlarmRules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// healthData returns the health attributes of the people counter. Unreported
+// states are left out.
+func healthData(health confmodel.SensorHealth) map[string]any {
+	data := map[string]any{
+		"tilted":  boolValue(health.Tilted),
+		"covered": boolValue(health.Covered),
+		"health":  health.Level(),
+	}
+	if health.Online != nil {
+		data["online"] = boolValue(*health.Online)
+	}
+	if health.Illumination != nil {
+		data["illumination"] = *health.Illumination
+	}
+	return data
+}
+
+func boolValue(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,343 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package datapush
+
+import (
+	"context"
+	"maps"
+	"math"
+	"strings"
+	"sync"
+	"time"
+	"xovis/conf"
+	"xovis/eliona"
+	confmodel "xovis/model/conf"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// Open heatmaps are added to the stored ones at least this often, so the
+// current window can be queried, and when the app stops.
+const heatmapFlushInterval = time.Minute
+
+// heatmapStoreTimeout bounds storing the open heatmaps when the app stops,
+// after the packages being processed are finished.
+const heatmapStoreTimeout = 5 * time.Second
+
+// maxHeatmapCells limits the grid of a zone, as a small cell size on a large
+// zone would use a lot of memory.
+const maxHeatmapCells = 100_000
+
+// Object types of the tracked objects counted separately.
+const (
+	objectPerson = "PERSON"
+	objectGroup  = "GROUP"
+)
+
+// tracking accumulates the tracked objects of the live pushes of all sensors.
+var tracking = tracker{
+	geometries: map[string][]Geometry{},
+	heatmaps:   map[heatmapKey]*openHeatmap{},
+	counts:     map[string]map[string]any{},
+}
+
+type tracker struct {
+	mu sync.Mutex
+
+	// Zones of the sensors, from the last push including the configuration.
+	geometries map[string][]Geometry
+
+	heatmaps map[heatmapKey]*openHeatmap
+
+	// Counts last written to the people counters.
+	counts map[string]map[string]any
+}
+
+type heatmapKey struct {
+	serialNumber string
+	geometryID   int
+	windowStart  int64
+}
+
+// openHeatmap counts the positions of the current window not yet added to
+// the stored heatmap.
+type openHeatmap struct {
+	heatmap confmodel.Heatmap
+	polygon [][]float64
+	flushed time.Time
+}
+
+// processTrackedObjects counts the tracked objects of the sensor per type and
+// accumulates their positions in the heatmaps of its zones, if tracking is
+// enabled for the configuration of the people counter.
+func processTrackedObjects(ctx context.Context, data Data) {
+	live := data.LiveData
+	serialNumber := live.SensorInfo.SerialNumber
+	if serialNumber == "" || len(live.Frames) == 0 && len(live.Config.Geometries) == 0 {
+		return
+	}
+	asset, ok, err := peopleCounterAsset(serialNumber)
+	if err != nil {
+		log.Error("datapush", "%v", err)
+		return
+	}
+	if !ok || !asset.Config.TrackingEnabled {
+		return
+	}
+
+	now := time.Now()
+	counts, timestamp, flushed := tracking.add(serialNumber, data, asset.Config, now)
+	storeHeatmaps(ctx, flushed)
+	if counts == nil {
+		return
+	}
+	if err := eliona.UpsertAssetDataAt(ctx, asset.Config, asset.AssetID, counts, timestamp); err != nil {
+		log.Error("datapush", "upserting tracked objects: %v", err)
+		return
+	}
+	log.Debug("datapush", "set %v data %+v", asset.AssetID, counts)
+}
+
+// add accumulates the frames of the push. It returns the counts of the last
+// frame with tracked objects if they changed, with the time of the frame, and
+// the heatmaps to be added to the stored ones.
+func (t *tracker) add(serialNumber string, data Data, config confmodel.Configuration, now time.Time) (map[string]any, time.Time, []confmodel.Heatmap) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if geometries := data.LiveData.Config.Geometries; len(geometries) > 0 {
+		t.geometries[serialNumber] = geometries
+	}
+	window := time.Duration(config.HeatmapWindow) * time.Second
+	if window <= 0 {
+		window = confmodel.DefaultHeatmapWindow * time.Second
+	}
+	cellSize := config.HeatmapCellSize
+	if cellSize <= 0 {
+		cellSize = confmodel.DefaultHeatmapCellSize
+	}
+
+	var flushed []confmodel.Heatmap
+	var last *Frame
+	for i, frame := range data.LiveData.Frames {
+		if frame.TrackedObjects == nil {
+			continue // not included in the push
+		}
+		last = &data.LiveData.Frames[i]
+		frameTime := now
+		if frame.Time > 0 {
+			frameTime = time.UnixMilli(frame.Time)
+		}
+		windowStart := frameTime.Truncate(window)
+		for _, geometry := range t.geometries[serialNumber] {
+			heatmap, replaced := t.heatmap(serialNumber, geometry, windowStart, window, cellSize, now)
+			if replaced != nil {
+				flushed = append(flushed, *replaced)
+			}
+			if heatmap != nil {
+				heatmap.count(frame.TrackedObjects)
+			}
+		}
+	}
+	flushed = append(flushed, t.flush(now)...)
+
+	if last == nil {
+		return nil, time.Time{}, flushed
+	}
+	counts := objectCounts(last.TrackedObjects)
+	if previous, ok := t.counts[serialNumber]; ok && maps.Equal(previous, counts) {
+		return nil, time.Time{}, flushed
+	}
+	t.counts[serialNumber] = counts
+	var timestamp time.Time
+	if last.Time > 0 {
+		timestamp = time.UnixMilli(last.Time)
+	}
+	return counts, timestamp, flushed
+}
+
+// heatmap returns the open heatmap of the zone for the window, or nil if the
+// geometry is no zone a heatmap can be made of. An open heatmap with another
+// grid, e.g. after the zone or the cell size was changed, is replaced and
+// returned to be stored.
+func (t *tracker) heatmap(serialNumber string, geometry Geometry, windowStart time.Time, window time.Duration, cellSize float64, now time.Time) (*openHeatmap, *confmodel.Heatmap) {
+	if !strings.EqualFold(geometry.Type, confmodel.GeometryZone) || len(geometry.Geometry) < 3 {
+		return nil, nil
+	}
+	grid, ok := heatmapGrid(geometry, cellSize)
+	if !ok {
+		log.Warn("datapush", "zone %d of sensor %s too large for heatmap cells of %v m", geometry.ID, serialNumber, cellSize)
+		return nil, nil
+	}
+
+	key := heatmapKey{serialNumber: serialNumber, geometryID: geometry.ID, windowStart: windowStart.Unix()}
+	var replaced *confmodel.Heatmap
+	if open, ok := t.heatmaps[key]; ok {
+		if open.heatmap.OriginX == grid.OriginX && open.heatmap.OriginY == grid.OriginY &&
+			open.heatmap.CellSize == grid.CellSize && open.heatmap.Columns == grid.Columns && open.heatmap.Rows == grid.Rows {
+			return open, nil
+		}
+		if open.heatmap.Frames > 0 {
+			replaced = &open.heatmap
+		}
+	}
+	grid.SerialNumber = serialNumber
+	grid.Cells = make([]int64, grid.Columns*grid.Rows)
+	grid.WindowStart = windowStart
+	grid.WindowEnd = windowStart.Add(window)
+	open := &openHeatmap{heatmap: grid, polygon: geometry.Geometry, flushed: now}
+	t.heatmaps[key] = open
+	return open, replaced
+}
+
+// flush returns the heatmaps with counts of ended windows and those not added
+// to the stored ones for heatmapFlushInterval. Heatmaps of ended windows are
+// closed, the others start counting from zero again.
+func (t *tracker) flush(now time.Time) []confmodel.Heatmap {
+	var flushed []confmodel.Heatmap
+	for key, open := range t.heatmaps {
+		ended := !open.heatmap.WindowEnd.After(now)
+		if !ended && now.Sub(open.flushed) < heatmapFlushInterval {
+			continue
+		}
+		if open.heatmap.Frames > 0 {
+			flushed = append(flushed, open.heatmap)
+		}
+		if ended {
+			delete(t.heatmaps, key)
+			continue
+		}
+		open.heatmap.Cells = make([]int64, len(open.heatmap.Cells))
+		open.heatmap.Frames = 0
+		open.flushed = now
+	}
+	return flushed
+}
+
+// closeHeatmaps returns the heatmaps with counts not added to the stored ones
+// yet and closes all heatmaps.
+func (t *tracker) closeHeatmaps() []confmodel.Heatmap {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var closed []confmodel.Heatmap
+	for key, open := range t.heatmaps {
+		if open.heatmap.Frames > 0 {
+			closed = append(closed, open.heatmap)
+		}
+		delete(t.heatmaps, key)
+	}
+	return closed
+}
+
+// storeHeatmaps adds the heatmaps to the stored ones.
+func storeHeatmaps(ctx context.Context, heatmaps []confmodel.Heatmap) {
+	for _, heatmap := range heatmaps {
+		if err := conf.AddHeatmap(ctx, heatmap); err != nil {
+			log.Error("datapush", "storing heatmap of zone %d of sensor %s: %v", heatmap.GeometryID, heatmap.SerialNumber, err)
+		}
+	}
+}
+
+// heatmapGrid returns a heatmap without cells with a grid covering the
+// bounding box of the zone. It fails if the grid would have more than
+// maxHeatmapCells.
+func heatmapGrid(geometry Geometry, cellSize float64) (confmodel.Heatmap, bool) {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, point := range geometry.Geometry {
+		if len(point) < 2 {
+			continue
+		}
+		minX, maxX = math.Min(minX, point[0]), math.Max(maxX, point[0])
+		minY, maxY = math.Min(minY, point[1]), math.Max(maxY, point[1])
+	}
+	if math.IsInf(minX, 0) {
+		return confmodel.Heatmap{}, false
+	}
+	columns := max(1, math.Ceil((maxX-minX)/cellSize))
+	rows := max(1, math.Ceil((maxY-minY)/cellSize))
+	if columns*rows > maxHeatmapCells {
+		return confmodel.Heatmap{}, false
+	}
+	return confmodel.Heatmap{
+		GeometryID:   int32(geometry.ID),
+		GeometryName: geometry.Name,
+		OriginX:      minX,
+		OriginY:      minY,
+		CellSize:     cellSize,
+		Columns:      int32(columns),
+		Rows:         int32(rows),
+	}, true
+}
+
+// count adds the positions of the objects of a frame within the zone.
+func (h *openHeatmap) count(objects []TrackedObject) {
+	h.heatmap.Frames++
+	for _, object := range objects {
+		if len(object.Position) < 2 {
+			continue
+		}
+		x, y := object.Position[0], object.Position[1]
+		if !insidePolygon(x, y, h.polygon) {
+			continue
+		}
+		column := min(int32((x-h.heatmap.OriginX)/h.heatmap.CellSize), h.heatmap.Columns-1)
+		row := min(int32((y-h.heatmap.OriginY)/h.heatmap.CellSize), h.heatmap.Rows-1)
+		if column < 0 || row < 0 {
+			continue
+		}
+		h.heatmap.Cells[row*h.heatmap.Columns+column]++
+	}
+}
+
+// insidePolygon tells whether the point is inside the polygon, using the
+// even-odd rule.
+func insidePolygon(x, y float64, polygon [][]float64) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		if len(polygon[i]) < 2 || len(polygon[j]) < 2 {
+			continue
+		}
+		xi, yi := polygon[i][0], polygon[i][1]
+		xj, yj := polygon[j][0], polygon[j][1]
+		if (yi > y) != (yj > y) && x < (xj-xi)*(y-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// objectCounts returns the people counter attributes of the objects in a
+// frame.
+func objectCounts(objects []TrackedObject) map[string]any {
+	var persons, groups, other int
+	for _, object := range objects {
+		switch strings.ToUpper(object.Type) {
+		case objectPerson:
+			persons++
+		case objectGroup:
+			groups++
+		default:
+			other++
+		}
+	}
+	return map[string]any{
+		"tracked_objects": len(objects),
+		"tracked_persons": persons,
+		"tracked_groups":  groups,
+		"tracked_other":   other,
+	}
+}
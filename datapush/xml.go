@@ -0,0 +1,183 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package datapush
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+)
+
+// isXML tells whether the datapush is in XML, by the content type if it is
+// an XML or JSON type and by the body otherwise, e.g. for MQTT messages.
+func isXML(contentType string, body []byte) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err == nil {
+		switch {
+		case mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml"):
+			return true
+		case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+			return false
+		}
+	}
+	return bytes.HasPrefix(bytes.TrimLeft(body, "\ufeff \t\r\n"), []byte("<"))
+}
+
+// decodeXML parses a datapush in XML into the same model as the JSON
+// packages. The package is the root element, <live_data>, <logics_data> or
+// <status_data>, with elements named like the fields of the JSON packages.
+// Lists are wrapped in an element named like the list, with an element named
+// in singular per item, e.g. <frames><frame>. Positions and the points of
+// geometries are elements with the coordinates as attributes x, y and z.
+func decodeXML(body []byte, data *Data) error {
+	decoder := xml.NewDecoder(bytes.NewReader(bytes.TrimPrefix(body, []byte("\ufeff"))))
+	root, err := rootElement(decoder)
+	if err != nil {
+		return err
+	}
+	switch root.Name.Local {
+	case "live_data":
+		err = decoder.DecodeElement(&data.LiveData, &root)
+	case "logics_data":
+		data.LogicsData = &LogicsData{}
+		err = decoder.DecodeElement(data.LogicsData, &root)
+	case "status_data":
+		data.StatusData = &StatusData{}
+		err = decoder.DecodeElement(data.StatusData, &root)
+	default:
+		return fmt.Errorf("unknown package <%s>", root.Name.Local)
+	}
+	if err != nil {
+		return err
+	}
+	return checkEnd(decoder)
+}
+
+// rootElement returns the start of the root element, skipping the XML
+// declaration and comments.
+func rootElement(decoder *xml.Decoder) (xml.StartElement, error) {
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return xml.StartElement{}, errors.New("no root element")
+		}
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			return token, nil
+		case xml.CharData:
+			if len(bytes.TrimSpace(token)) > 0 {
+				return xml.StartElement{}, errors.New("text outside of the root element")
+			}
+		}
+	}
+}
+
+// checkEnd fails if anything but comments follows the root element.
+func checkEnd(decoder *xml.Decoder) error {
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			return errors.New("more than one root element")
+		case xml.CharData:
+			if len(bytes.TrimSpace(token)) > 0 {
+				return errors.New("text outside of the root element")
+			}
+		}
+	}
+}
+
+// xmlPoint is a position or a point of a geometry.
+type xmlPoint struct {
+	X float64  `xml:"x,attr"`
+	Y float64  `xml:"y,attr"`
+	Z *float64 `xml:"z,attr"`
+}
+
+func (p xmlPoint) coordinates() []float64 {
+	if p.Z == nil {
+		return []float64{p.X, p.Y}
+	}
+	return []float64{p.X, p.Y, *p.Z}
+}
+
+// UnmarshalXML reads the points of the geometry from <geometry><point>.
+func (g *Geometry) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	type geometry Geometry
+	var decoded struct {
+		geometry
+		Points []xmlPoint `xml:"geometry>point"`
+	}
+	if err := decoder.DecodeElement(&decoded, &start); err != nil {
+		return err
+	}
+	*g = Geometry(decoded.geometry)
+	for _, point := range decoded.Points {
+		g.Geometry = append(g.Geometry, point.coordinates())
+	}
+	return nil
+}
+
+// UnmarshalXML reads the tracked objects from <tracked_objects><tracked_object>,
+// which are empty rather than nil if the element is empty, like in JSON.
+func (f *Frame) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	type frame Frame
+	var decoded struct {
+		frame
+		TrackedObjects *struct {
+			Items []TrackedObject `xml:"tracked_object"`
+		} `xml:"tracked_objects"`
+	}
+	if err := decoder.DecodeElement(&decoded, &start); err != nil {
+		return err
+	}
+	*f = Frame(decoded.frame)
+	if decoded.TrackedObjects != nil {
+		f.TrackedObjects = append([]TrackedObject{}, decoded.TrackedObjects.Items...)
+	}
+	return nil
+}
+
+// UnmarshalXML reads the position of the object from the attributes of
+// <position>.
+func (o *TrackedObject) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	type trackedObject TrackedObject
+	var decoded struct {
+		trackedObject
+		Position *xmlPoint `xml:"position"`
+	}
+	if err := decoder.DecodeElement(&decoded, &start); err != nil {
+		return err
+	}
+	*o = TrackedObject(decoded.trackedObject)
+	if decoded.Position != nil {
+		o.Position = decoded.Position.coordinates()
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package datapush
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeXML(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		want        string // JSON fixture with the same package, none if the body is invalid
+	}{
+		{name: "live data", body: "live.xml", contentType: "application/xml", want: "live.json"},
+		{name: "logics", body: "logics.xml", contentType: "text/xml; charset=utf-8", want: "logics.json"},
+		{name: "recognized by the body", body: "live.xml", want: "live.json"},
+		{name: "malformed", body: "malformed.xml", contentType: "application/xml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Decode(tt.contentType, readFixture(t, tt.body))
+			if tt.want == "" {
+				if err == nil {
+					t.Fatalf("decoding %s succeeded, want an error", tt.body)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decoding %s: %v", tt.body, err)
+			}
+			want, err := Decode("application/json", readFixture(t, tt.want))
+			if err != nil {
+				t.Fatalf("decoding %s: %v", tt.want, err)
+			}
+			if !reflect.DeepEqual(data, want) {
+				t.Errorf("decoded %s:\n%+v\nwant as %s:\n%+v", tt.body, data, tt.want, want)
+			}
+		})
+	}
+}
+
+func TestDecodeXMLInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "empty", body: ""},
+		{name: "unknown package", body: "<count_data><id>1</id></count_data>"},
+		{name: "invalid number", body: "<live_data><package_info><id>abc</id></package_info></live_data>"},
+		{name: "invalid bin time", body: "<logics_data><logics><logic><records><record><from>yesterday</from></record></records></logic></logics></logics_data>"},
+		{name: "two root elements", body: "<live_data></live_data><live_data></live_data>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode("application/xml", []byte(tt.body)); err == nil {
+				t.Errorf("decoding %q succeeded, want an error", tt.body)
+			}
+		})
+	}
+}
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
@@ -0,0 +1,68 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
+)
+
+// alarmRulesEnsured remembers the assets whose alarm rules were checked, so
+// that Eliona is asked only once per asset while the app runs.
+var alarmRulesEnsured sync.Map
+
+// EnsureAlarmRules creates the rules for the asset whose attribute has no rule
+// yet. Existing rules, also ones changed by the user, are kept.
+func EnsureAlarmRules(ctx context.Context, assetID int32, rules []api.AlarmRule) error {
+	if _, ok := alarmRulesEnsured.Load(assetID); ok {
+		return nil
+	}
+	existing, _, err := client.NewClient().AlarmRulesAPI.
+		GetAlarmRules(client.AuthenticationContextWrap(ctx)).
+		AssetId(assetID).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("getting alarm rules of asset %v: %v", assetID, err)
+	}
+	for _, rule := range rules {
+		if hasAlarmRule(existing, rule) {
+			continue
+		}
+		rule.AssetId = assetID
+		_, _, err := client.NewClient().AlarmRulesAPI.
+			PostAlarmRule(client.AuthenticationContextWrap(ctx)).
+			AlarmRule(rule).
+			Execute()
+		if err != nil {
+			return fmt.Errorf("creating alarm rule for %s of asset %v: %v", rule.Attribute, assetID, err)
+		}
+	}
+	alarmRulesEnsured.Store(assetID, true)
+	return nil
+}
+
+func hasAlarmRule(rules []api.AlarmRule, rule api.AlarmRule) bool {
+	for _, existing := range rules {
+		if existing.Subtype == rule.Subtype && existing.Attribute == rule.Attribute {
+			return true
+		}
+	}
+	return false
+}
@@ -16,8 +16,12 @@
 package eliona
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
+	"xovis/metrics"
 	confmodel "xovis/model/conf"
 
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
@@ -26,16 +30,21 @@ import (
 	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
-func CreateAssetsAndUpsertData(config confmodel.Configuration, root asset.Root) error {
+func CreateAssetsAndUpsertData(ctx context.Context, config confmodel.Configuration, root asset.Root) error {
 	cr := ClientReference
 	now := time.Now()
 	for _, projectId := range config.ProjectIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		start := time.Now()
 		assetsCreated, err := asset.CreateAssetsAndUpsertData(root, projectId, &now, &cr)
+		metrics.ElionaUpsertDuration.WithLabelValues("assets").Observe(time.Since(start).Seconds())
 		if err != nil {
 			return err
 		}
 		if assetsCreated != 0 {
-			if err := notifyUser(config.UserId, projectId, assetsCreated); err != nil {
+			if err := notifyUser(ctx, config.UserId, projectId, assetsCreated); err != nil {
 				return fmt.Errorf("notifying user about CAC: %v", err)
 			}
 		}
@@ -43,9 +52,25 @@ func CreateAssetsAndUpsertData(config confmodel.Configuration, root asset.Root)
 	return nil
 }
 
-func notifyUser(userId string, projectId string, assetsCreated int) error {
+var ErrAssetNotFound = errors.New("asset not found")
+
+// GetAsset reads an existing asset from Eliona.
+func GetAsset(ctx context.Context, assetID int32) (api.Asset, error) {
+	asset, resp, err := client.NewClient().AssetsAPI.
+		GetAssetById(client.AuthenticationContextWrap(ctx), assetID).
+		Execute()
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return api.Asset{}, fmt.Errorf("%w: %v", ErrAssetNotFound, assetID)
+	}
+	if err != nil {
+		return api.Asset{}, fmt.Errorf("getting asset %v: %v", assetID, err)
+	}
+	return *asset, nil
+}
+
+func notifyUser(ctx context.Context, userId string, projectId string, assetsCreated int) error {
 	receipt, _, err := client.NewClient().CommunicationAPI.
-		PostNotification(client.AuthenticationContext()).
+		PostNotification(client.AuthenticationContextWrap(ctx)).
 		Notification(
 			api.Notification{
 				User:      userId,
@@ -16,23 +16,65 @@
 package eliona
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"time"
+	"xovis/metrics"
 	confmodel "xovis/model/conf"
 
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
-	"github.com/eliona-smart-building-assistant/go-eliona/asset"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
 )
 
 const ClientReference string = "xovis"
 
-func UpsertAssetData(config confmodel.Configuration, assetID int32, data map[string]any) error {
+// UpsertAssetData writes input data to the asset. Assets deleted in Eliona are
+// skipped.
+func UpsertAssetData(ctx context.Context, config confmodel.Configuration, assetID int32, data map[string]any) error {
+	return UpsertAssetDataAt(ctx, config, assetID, data, time.Time{})
+}
+
+// UpsertAssetDataAt writes input data measured at the given time to the asset.
+// A zero time stands for now.
+func UpsertAssetDataAt(ctx context.Context, config confmodel.Configuration, assetID int32, data map[string]any, timestamp time.Time) error {
+	return upsertAssetData(ctx, assetID, api.SUBTYPE_INPUT, data, timestamp)
+}
+
+// UpsertAssetStatusAt writes status data reported at the given time to the
+// asset. A zero time stands for now.
+func UpsertAssetStatusAt(ctx context.Context, assetID int32, data map[string]any, timestamp time.Time) error {
+	return upsertAssetData(ctx, assetID, api.SUBTYPE_STATUS, data, timestamp)
+}
+
+func upsertAssetData(ctx context.Context, assetID int32, subtype api.DataSubtype, data map[string]any, timestamp time.Time) error {
 	apiData := api.Data{
 		AssetId:         assetID,
 		Data:            data,
 		ClientReference: *api.NewNullableString(api.PtrString(ClientReference)),
-		Subtype:         api.SUBTYPE_INPUT,
+		Subtype:         subtype,
+	}
+	if !timestamp.IsZero() {
+		apiData.Timestamp = *api.NewNullableTime(&timestamp)
+	}
+	start := time.Now()
+	defer func() {
+		metrics.ElionaUpsertDuration.WithLabelValues("data").Observe(time.Since(start).Seconds())
+	}()
+	_, resp, err := client.NewClient().AssetsAPI.
+		GetAssetById(client.AuthenticationContextWrap(ctx), assetID).
+		Execute()
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("checking if asset %v exists: %v", assetID, err)
 	}
-	if err := asset.UpsertDataIfAssetExists(apiData); err != nil {
+	_, err = client.NewClient().DataAPI.
+		PutData(client.AuthenticationContextWrap(ctx)).
+		Data(apiData).
+		Execute()
+	if err != nil {
 		return fmt.Errorf("upserting data: %v", err)
 	}
 	return nil
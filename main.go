@@ -16,7 +16,12 @@
 package main
 
 import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
+	"xovis/conf"
 
 	"github.com/eliona-smart-building-assistant/go-eliona/app"
 	"github.com/eliona-smart-building-assistant/go-utils/common"
@@ -44,14 +49,34 @@ func main() {
 	// Necessary to close used init resources, because db.Pool() is used in this app.
 	defer db.ClosePool()
 
+	// Replay archived datapushes instead of starting the app.
+	if len(os.Args) > 1 && os.Args[1] == "replay" {
+		os.Exit(replayCommand(os.Args[2:]))
+	}
+
 	// Initialize the app
 	initialization()
 
+	// Cancelled on termination signals, which stops all services gracefully.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGQUIT, syscall.SIGINT)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		time.Sleep(shutdownTimeout + 5*time.Second)
+		log.Fatal("main", "Graceful shutdown did not finish in time.")
+	}()
+
 	// Starting the service to collect the data for this app.
-	common.WaitForWithOs(
-		common.Loop(collectData, time.Second),
-		listenApi,
+	collectors := newCollectors()
+	common.WaitFor(
+		func() { collectData(ctx, collectors) },
+		func() { listenApi(ctx, collectors) },
+		func() { processDatapushes(ctx) },
 	)
 
+	if _, err := conf.SetAllConfigsInactive(context.Background()); err != nil {
+		log.Error("conf", "Couldn't set configs inactive: %v", err)
+	}
+
 	log.Info("main", "Terminate the app.")
 }
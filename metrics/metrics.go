@@ -0,0 +1,144 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package metrics defines the Prometheus metrics exposed by the app on /metrics.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "xovis"
+
+// Reasons for dropping datapush events.
+const (
+	DropUnknownAsset   = "unknown_asset"
+	DropUnknownCounter = "unknown_counter"
+	DropLookupFailed   = "lookup_failed"
+	DropUpsertFailed   = "upsert_failed"
+	DropUnknownStatus  = "unknown_status"
+	DropDuplicate      = "duplicate" // the package was received before
+	DropStale          = "stale"     // a later frame of the counter was written before
+)
+
+// Results of MQTT messages.
+const (
+	MQTTProcessed = "processed"
+	MQTTInvalid   = "invalid"
+	MQTTFailed    = "failed" // the message could not be queued
+)
+
+// Results of processing queued datapush packages.
+const (
+	QueueProcessed    = "processed"
+	QueueRetried      = "retried"
+	QueueDeadLettered = "dead_lettered"
+)
+
+var (
+	SensorRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "sensor_request_duration_seconds",
+		Help:      "Duration of requests to the sensor API by sensor and endpoint.",
+	}, []string{"sensor", "endpoint"})
+
+	SensorRequestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sensor_request_errors_total",
+		Help:      "Failed requests to the sensor API by sensor and endpoint.",
+	}, []string{"sensor", "endpoint"})
+
+	SensorRequestRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sensor_request_retries_total",
+		Help:      "Retried requests to the sensor API by sensor and endpoint.",
+	}, []string{"sensor", "endpoint"})
+
+	SensorCircuitOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "sensor_circuit_open",
+		Help:      "Whether the sensor is skipped after repeatedly failing (1) or not (0).",
+	}, []string{"sensor"})
+
+	TaskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "task_duration_seconds",
+		Help:      "Duration of collection and discovery cycles by configuration.",
+		Buckets:   []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{"config", "task"})
+
+	DatapushRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "datapush_requests_total",
+		Help:      "Datapush requests received by response status code.",
+	}, []string{"code"})
+
+	DatapushEventsProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "datapush_events_processed_total",
+		Help:      "Datapush events written to Eliona by category.",
+	}, []string{"category"})
+
+	DatapushEventsDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "datapush_events_dropped_total",
+		Help:      "Datapush events not written to Eliona by reason.",
+	}, []string{"reason"})
+
+	DatapushDuplicatePackages = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "datapush_duplicate_packages_total",
+		Help:      "Live data packages skipped as they were received before.",
+	})
+
+	DatapushQueued = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "datapush_queued_total",
+		Help:      "Datapush packages added to the queue.",
+	})
+
+	DatapushQueueResults = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "datapush_queue_results_total",
+		Help:      "Attempts to process queued datapush packages by result.",
+	}, []string{"result"})
+
+	MQTTMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "mqtt_messages_total",
+		Help:      "Datapush messages received over MQTT by configuration and result.",
+	}, []string{"config", "result"})
+
+	MQTTConnected = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "mqtt_connected",
+		Help:      "Whether the configuration is connected to its MQTT broker (1) or not (0).",
+	}, []string{"config"})
+
+	ElionaUpsertDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "eliona_upsert_duration_seconds",
+		Help:      "Duration of upserts to Eliona by operation.",
+	}, []string{"operation"})
+)
+
+// Handler serves the metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -18,9 +18,12 @@ package assetmodel
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"xovis/conf"
 	confmodel "xovis/model/conf"
 
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-eliona/asset"
 )
 
@@ -29,6 +32,12 @@ type Zone struct {
 	Name     string
 	Presence int `eliona:"presence" subtype:"input"`
 
+	// GeoJSON of the zone's geometries, nil until their points are known.
+	Geometry *string `eliona:"geometry" subtype:"info"`
+
+	// Geometries of the logic as read from the sensor, without points.
+	Geometries []confmodel.Geometry
+
 	DeviceMac string
 
 	Config *confmodel.Configuration
@@ -61,6 +70,10 @@ func (d *Zone) SetAssetID(assetID int32, projectID string) error {
 	return nil
 }
 
+func (d *Zone) geometry() *string {
+	return d.Geometry
+}
+
 func (d *Zone) GetLocationalChildren() []asset.LocationalNode {
 	return []asset.LocationalNode{}
 }
@@ -75,6 +88,12 @@ type Line struct {
 	Forward  int `eliona:"forward" subtype:"input"`
 	Backward int `eliona:"backward" subtype:"input"`
 
+	// GeoJSON of the line's geometries, nil until their points are known.
+	Geometry *string `eliona:"geometry" subtype:"info"`
+
+	// Geometries of the logic as read from the sensor, without points.
+	Geometries []confmodel.Geometry
+
 	DeviceMac string
 
 	Config *confmodel.Configuration
@@ -107,6 +126,10 @@ func (d *Line) SetAssetID(assetID int32, projectID string) error {
 	return nil
 }
 
+func (d *Line) geometry() *string {
+	return d.Geometry
+}
+
 func (d *Line) GetLocationalChildren() []asset.LocationalNode {
 	return []asset.LocationalNode{}
 }
@@ -116,12 +139,21 @@ func (d *Line) GetFunctionalChildren() []asset.FunctionalNode {
 }
 
 type PeopleCounter struct {
-	MAC   string `eliona:"mac" subtype:"info"`
-	Name  string
-	Model string `eliona:"model" subtype:"info"`
+	MAC        string `eliona:"mac" subtype:"info"`
+	Name       string
+	Model      string `eliona:"model" subtype:"info"`
+	Serial     string `eliona:"serial" subtype:"info"`
+	Firmware   string `eliona:"firmware" subtype:"info"`
+	IP         string `eliona:"ip" subtype:"info"`
+	Uptime     *int64 `eliona:"uptime" subtype:"info"`      // seconds since the last boot
+	TimeOffset *int64 `eliona:"time_offset" subtype:"info"` // seconds the device clock is ahead
 
 	Group string // Group name used just for pairing
 
+	// GAI of an existing asset the counter is placed under instead of its
+	// group. The group stays the functional parent.
+	LocationalParentGAI string
+
 	Lines []Line
 	Zones []Zone
 
@@ -157,29 +189,57 @@ func (d *PeopleCounter) SetAssetID(assetID int32, projectID string) error {
 
 func (d *PeopleCounter) GetLocationalChildren() []asset.LocationalNode {
 	var locationalChildren []asset.LocationalNode
-	for i := range d.Lines {
-		locationalChildren = append(locationalChildren, &d.Lines[i])
-	}
-	for i := range d.Zones {
-		locationalChildren = append(locationalChildren, &d.Zones[i])
+	for _, child := range d.logics() {
+		locationalChildren = append(locationalChildren, child)
 	}
 	return locationalChildren
 }
 
 func (d *PeopleCounter) GetFunctionalChildren() []asset.FunctionalNode {
 	var functionalChildren []asset.FunctionalNode
+	for _, child := range d.logics() {
+		functionalChildren = append(functionalChildren, child)
+	}
+	return functionalChildren
+}
+
+// logics returns the lines and zones of the counter. If the logics push is
+// preferred, their counts are left to the push.
+func (d *PeopleCounter) logics() []logicNode {
+	var logics []logicNode
 	for i := range d.Lines {
-		functionalChildren = append(functionalChildren, &d.Lines[i])
+		logics = append(logics, &d.Lines[i])
 	}
 	for i := range d.Zones {
-		functionalChildren = append(functionalChildren, &d.Zones[i])
+		logics = append(logics, &d.Zones[i])
 	}
-	return functionalChildren
+	if d.Config != nil && d.Config.PreferLogicsPush {
+		for i := range logics {
+			logics[i] = &withoutCounts{logicNode: logics[i], Geometry: logics[i].geometry()}
+		}
+	}
+	return logics
+}
+
+type logicNode interface {
+	asset.LocationalNode
+	asset.FunctionalNode
+	geometry() *string
+}
+
+// withoutCounts creates the asset of the node, but writes only its geometry
+// to it, as the data are only read from tagged fields of the node itself.
+type withoutCounts struct {
+	logicNode
+	Geometry *string `eliona:"geometry" subtype:"info"`
 }
 
 type Group struct {
 	Name string
 
+	// Tag marks a group of sensors with the same tag instead of a Xovis group.
+	Tag bool
+
 	Sensors []PeopleCounter
 
 	Config *confmodel.Configuration
@@ -198,6 +258,9 @@ func (d *Group) GetAssetType() string {
 }
 
 func (d *Group) GetGAI() string {
+	if d.Tag {
+		return d.GetAssetType() + "_tag_" + d.Name
+	}
 	return d.GetAssetType() + "_" + d.Name
 }
 
@@ -213,9 +276,12 @@ func (d *Group) SetAssetID(assetID int32, projectID string) error {
 }
 
 func (d *Group) GetLocationalChildren() []asset.LocationalNode {
-	locationalChildren := make([]asset.LocationalNode, len(d.Sensors))
+	locationalChildren := make([]asset.LocationalNode, 0, len(d.Sensors))
 	for i := range d.Sensors {
-		locationalChildren[i] = &d.Sensors[i]
+		if d.Sensors[i].LocationalParentGAI != "" {
+			continue
+		}
+		locationalChildren = append(locationalChildren, &d.Sensors[i])
 	}
 	return locationalChildren
 }
@@ -228,9 +294,57 @@ func (d *Group) GetFunctionalChildren() []asset.FunctionalNode {
 	return functionalChildren
 }
 
+// ParentAsset is an existing Eliona asset, created outside of the app, that
+// people counters are placed under. It is never created nor updated.
+type ParentAsset struct {
+	AssetID int32
+	GAI     string
+	Name    string
+
+	Sensors []PeopleCounter
+}
+
+func (d *ParentAsset) GetName() string {
+	return d.Name
+}
+
+func (d *ParentAsset) GetDescription() string {
+	return ""
+}
+
+func (d *ParentAsset) GetAssetType() string {
+	return ""
+}
+
+func (d *ParentAsset) GetGAI() string {
+	return d.GAI
+}
+
+func (d *ParentAsset) GetAssetID(projectID string) (*int32, error) {
+	return &d.AssetID, nil
+}
+
+func (d *ParentAsset) SetAssetID(assetID int32, projectID string) error {
+	return fmt.Errorf("shouldn't happen: existing asset %v was created again", d.AssetID)
+}
+
+func (d *ParentAsset) GetLocationalChildren() []asset.LocationalNode {
+	locationalChildren := make([]asset.LocationalNode, len(d.Sensors))
+	for i := range d.Sensors {
+		locationalChildren[i] = &d.Sensors[i]
+	}
+	return locationalChildren
+}
+
 type Root struct {
 	Groups map[string]Group
 
+	// People counters directly under the root, e.g. in hierarchy mode flat.
+	Sensors []PeopleCounter
+
+	// Existing assets, people counters are placed under.
+	Parents []ParentAsset
+
 	Config *confmodel.Configuration
 }
 
@@ -261,20 +375,96 @@ func (r *Root) SetAssetID(assetID int32, projectID string) error {
 	return nil
 }
 
+// AddSensor places the people counter in the tree as given by the hierarchy
+// mode of the configuration, in its group, in the group of its tag or directly
+// under the root. If parent is set, the counter is placed under the existing
+// asset instead, and stays in its group only as functional child.
+func (r *Root) AddSensor(peopleCounter PeopleCounter, tag *string, parent *api.Asset) {
+	if parent != nil {
+		peopleCounter.LocationalParentGAI = parent.GlobalAssetIdentifier
+		r.placeUnder(*parent, peopleCounter)
+	}
+
+	groupName, isTag := peopleCounter.Group, false
+	switch r.Config.HierarchyMode {
+	case confmodel.HierarchyFlat:
+		r.Sensors = append(r.Sensors, peopleCounter)
+		return
+	case confmodel.HierarchyTag:
+		// Sensors without tag are placed directly under the root.
+		if tag == nil {
+			r.Sensors = append(r.Sensors, peopleCounter)
+			return
+		}
+		groupName, isTag = *tag, true
+	}
+	group, ok := r.Groups[groupName]
+	if !ok {
+		group = Group{
+			Name:    groupName,
+			Tag:     isTag,
+			Sensors: []PeopleCounter{},
+			Config:  r.Config,
+		}
+	}
+	group.Sensors = append(group.Sensors, peopleCounter)
+	r.Groups[groupName] = group
+}
+
+func (r *Root) placeUnder(parent api.Asset, peopleCounter PeopleCounter) {
+	for i := range r.Parents {
+		if r.Parents[i].AssetID == parent.GetId() {
+			r.Parents[i].Sensors = append(r.Parents[i].Sensors, peopleCounter)
+			return
+		}
+	}
+	r.Parents = append(r.Parents, ParentAsset{
+		AssetID: parent.GetId(),
+		GAI:     parent.GlobalAssetIdentifier,
+		Name:    parent.GetName(),
+		Sensors: []PeopleCounter{peopleCounter},
+	})
+}
+
+// sortedGroups returns the groups ordered by name, so that the tree is the same
+// in every run.
+func (r *Root) sortedGroups() []*Group {
+	groups := make([]*Group, 0, len(r.Groups))
+	for _, name := range slices.Sorted(maps.Keys(r.Groups)) {
+		group := r.Groups[name]
+		groups = append(groups, &group)
+	}
+	return groups
+}
+
+// GetLocationalChildren returns the parent assets first. The assets are
+// created with the parents they are reached through first, and people counters
+// placed under a parent asset are reached through their group as well, as
+// functional children.
 func (r *Root) GetLocationalChildren() []asset.LocationalNode {
-	locationalChildren := make([]asset.LocationalNode, len(r.Groups))
-	for _, group := range r.Groups {
-		groupCpy := group
-		locationalChildren = append(locationalChildren, &groupCpy)
+	var locationalChildren []asset.LocationalNode
+	for i := range r.Parents {
+		locationalChildren = append(locationalChildren, &r.Parents[i])
+	}
+	for _, group := range r.sortedGroups() {
+		locationalChildren = append(locationalChildren, group)
+	}
+	for i := range r.Sensors {
+		if r.Sensors[i].LocationalParentGAI != "" {
+			continue
+		}
+		locationalChildren = append(locationalChildren, &r.Sensors[i])
 	}
 	return locationalChildren
 }
 
 func (r *Root) GetFunctionalChildren() []asset.FunctionalNode {
-	functionalChildren := make([]asset.FunctionalNode, len(r.Groups))
-	for _, group := range r.Groups {
-		groupCpy := group
-		functionalChildren = append(functionalChildren, &groupCpy)
+	var functionalChildren []asset.FunctionalNode
+	for _, group := range r.sortedGroups() {
+		functionalChildren = append(functionalChildren, group)
+	}
+	for i := range r.Sensors {
+		functionalChildren = append(functionalChildren, &r.Sensors[i])
 	}
 	return functionalChildren
 }
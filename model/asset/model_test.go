@@ -0,0 +1,178 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package assetmodel
+
+import (
+	"slices"
+	"testing"
+	confmodel "xovis/model/conf"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-eliona/asset"
+)
+
+// children returns the GAIs of the locational and functional children of each
+// node of the tree, by the GAI of the node. Nodes without children are left out.
+func children(root asset.Root) (locational, functional map[string][]string) {
+	locational, functional = map[string][]string{}, map[string][]string{}
+	visited := map[string]bool{}
+	var visit func(node asset.Asset)
+	visit = func(node asset.Asset) {
+		gai := node.GetGAI()
+		if visited[gai] {
+			return
+		}
+		visited[gai] = true
+		if n, ok := node.(asset.LocationalNode); ok {
+			for _, child := range n.GetLocationalChildren() {
+				locational[gai] = append(locational[gai], child.GetGAI())
+				visit(child)
+			}
+		}
+		if n, ok := node.(asset.FunctionalNode); ok {
+			for _, child := range n.GetFunctionalChildren() {
+				functional[gai] = append(functional[gai], child.GetGAI())
+				visit(child)
+			}
+		}
+	}
+	visit(root)
+	return locational, functional
+}
+
+func TestAddSensor(t *testing.T) {
+	building := api.Asset{GlobalAssetIdentifier: "building_a"}
+	building.SetId(1234)
+	building.SetName("Building A")
+	tag := "entrances"
+
+	tests := []struct {
+		mode           string
+		tag            *string
+		wantLocational map[string][]string
+		wantFunctional map[string][]string
+	}{
+		{
+			mode: confmodel.HierarchyFlat,
+			wantLocational: map[string][]string{
+				"xovis_root":                  {"building_a", "xovis_people_counter_other"},
+				"building_a":                  {"xovis_people_counter_placed"},
+				"xovis_people_counter_placed": {"xovis_zone_placed_1"},
+			},
+			wantFunctional: map[string][]string{
+				"xovis_root":                  {"xovis_people_counter_placed", "xovis_people_counter_other"},
+				"xovis_people_counter_placed": {"xovis_zone_placed_1"},
+			},
+		},
+		{
+			mode: confmodel.HierarchyGroup,
+			wantLocational: map[string][]string{
+				"xovis_root":                  {"building_a", "xovis_group_Lobby"},
+				"building_a":                  {"xovis_people_counter_placed"},
+				"xovis_group_Lobby":           {"xovis_people_counter_other"},
+				"xovis_people_counter_placed": {"xovis_zone_placed_1"},
+			},
+			wantFunctional: map[string][]string{
+				"xovis_root":                  {"xovis_group_Lobby"},
+				"xovis_group_Lobby":           {"xovis_people_counter_placed", "xovis_people_counter_other"},
+				"xovis_people_counter_placed": {"xovis_zone_placed_1"},
+			},
+		},
+		{
+			mode: confmodel.HierarchyTag,
+			tag:  &tag,
+			wantLocational: map[string][]string{
+				"xovis_root":                  {"building_a", "xovis_group_tag_entrances"},
+				"building_a":                  {"xovis_people_counter_placed"},
+				"xovis_group_tag_entrances":   {"xovis_people_counter_other"},
+				"xovis_people_counter_placed": {"xovis_zone_placed_1"},
+			},
+			wantFunctional: map[string][]string{
+				"xovis_root":                  {"xovis_group_tag_entrances"},
+				"xovis_group_tag_entrances":   {"xovis_people_counter_placed", "xovis_people_counter_other"},
+				"xovis_people_counter_placed": {"xovis_zone_placed_1"},
+			},
+		},
+		{
+			mode: confmodel.HierarchyTag,
+			wantLocational: map[string][]string{
+				"xovis_root":                  {"building_a", "xovis_people_counter_other"},
+				"building_a":                  {"xovis_people_counter_placed"},
+				"xovis_people_counter_placed": {"xovis_zone_placed_1"},
+			},
+			wantFunctional: map[string][]string{
+				"xovis_root":                  {"xovis_people_counter_placed", "xovis_people_counter_other"},
+				"xovis_people_counter_placed": {"xovis_zone_placed_1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		name := tt.mode
+		if tt.mode == confmodel.HierarchyTag && tt.tag == nil {
+			name += " without tag"
+		}
+		t.Run(name, func(t *testing.T) {
+			root := Root{
+				Groups: map[string]Group{},
+				Config: &confmodel.Configuration{HierarchyMode: tt.mode},
+			}
+			placed := PeopleCounter{MAC: "placed", Group: "Lobby", Zones: []Zone{{ID: 1, DeviceMac: "placed"}}}
+			root.AddSensor(placed, tt.tag, &building)
+			root.AddSensor(PeopleCounter{MAC: "other", Group: "Lobby"}, tt.tag, nil)
+
+			locational, functional := children(&root)
+			assertChildren(t, "locational", locational, tt.wantLocational)
+			assertChildren(t, "functional", functional, tt.wantFunctional)
+		})
+	}
+}
+
+func assertChildren(t *testing.T, kind string, got, want map[string][]string) {
+	t.Helper()
+	for gai, wantChildren := range want {
+		if !slices.Equal(got[gai], wantChildren) {
+			t.Errorf("%s children of %s = %v, want %v", kind, gai, got[gai], wantChildren)
+		}
+	}
+	for gai, gotChildren := range got {
+		if _, ok := want[gai]; !ok {
+			t.Errorf("%s children of %s = %v, want none", kind, gai, gotChildren)
+		}
+	}
+}
+
+func TestAddSensorSharesParentAsset(t *testing.T) {
+	building := api.Asset{GlobalAssetIdentifier: "building_a"}
+	building.SetId(1234)
+	root := Root{
+		Groups: map[string]Group{},
+		Config: &confmodel.Configuration{HierarchyMode: confmodel.HierarchyFlat},
+	}
+	root.AddSensor(PeopleCounter{MAC: "first"}, nil, &building)
+	root.AddSensor(PeopleCounter{MAC: "second"}, nil, &building)
+
+	if len(root.Parents) != 1 {
+		t.Fatalf("%d parent assets, want 1", len(root.Parents))
+	}
+	locational, _ := children(&root)
+	want := []string{"xovis_people_counter_first", "xovis_people_counter_second"}
+	if got := locational["building_a"]; !slices.Equal(got, want) {
+		t.Errorf("locational children of building_a = %v, want %v", got, want)
+	}
+	if id, _ := root.Parents[0].GetAssetID(""); id == nil || *id != 1234 {
+		t.Errorf("asset ID of the parent = %v, want 1234", id)
+	}
+}
@@ -15,6 +15,11 @@
 
 package confmodel
 
+import (
+	"strings"
+	"time"
+)
+
 type Configuration struct {
 	ID               int64
 	CheckCertificate bool
@@ -24,8 +29,90 @@ type Configuration struct {
 	Active           bool
 	ProjectIDs       []string
 	UserId           string
+	HierarchyMode    string
+
+	// PEM encoded CA certificates trusted in addition to the system's CAs if
+	// CheckCertificate is set.
+	CABundle *string
+
+	// Proxy the sensors are reached through, nil to connect directly.
+	ProxyURL *string
+
+	// MQTT broker the sensors push their data to and the topics subscribed
+	// there, nil to receive datapushes through the webhook only.
+	MQTTBrokerURL *string
+	MQTTTopics    []string
+	MQTTUsername  *string
+	MQTTPassword  *string
+
+	// Counts of lines and zones are written by the logics push only, the
+	// collection just creates their assets.
+	PreferLogicsPush bool
+
+	// Alarm rules are created for the health attributes of the people counters.
+	HealthAlarms bool
+
+	// Tracked objects of live pushes are counted and accumulated in heatmaps
+	// of the zones with cells of HeatmapCellSize meters for windows of
+	// HeatmapWindow seconds.
+	TrackingEnabled bool
+	HeatmapCellSize float64
+	HeatmapWindow   int32
+
+	// The raw datapush bodies are archived per sensor for ArchiveMaxAge
+	// seconds, keeping at most ArchiveMaxSize bytes per sensor.
+	ArchiveEnabled bool
+	ArchiveMaxAge  int32
+	ArchiveMaxSize int64
+
+	// Tracked objects of live pushes are classified as staff if tagged with
+	// StaffTag, as children if shorter than ChildMaxHeight meters, as groups
+	// if of at least GroupMinMembers members and as adults otherwise. Lines
+	// and zones get separate counts per class.
+	ClassificationEnabled bool
+	ChildMaxHeight        float64
+	GroupMinMembers       int32
+	StaffTag              string
 }
 
+// Defaults of the heatmap grid and window.
+const (
+	DefaultHeatmapCellSize = 0.5 // meters
+	DefaultHeatmapWindow   = 900 // seconds
+)
+
+// Defaults of the datapush archive.
+const (
+	DefaultArchiveMaxAge  = 7 * 24 * 60 * 60 // seconds
+	DefaultArchiveMaxSize = 10 << 20         // bytes per sensor
+)
+
+// Defaults of the classification of tracked objects.
+const (
+	DefaultChildMaxHeight  = 1.4 // meters
+	DefaultGroupMinMembers = 2
+	DefaultStaffTag        = "staff"
+)
+
+// ConnectAddress returns the host and port the app connects to.
+func (s Sensor) ConnectAddress() (string, int32) {
+	host, port := s.Hostname, s.Port
+	if s.ConnectHostname != nil {
+		host = *s.ConnectHostname
+	}
+	if s.ConnectPort != nil {
+		port = *s.ConnectPort
+	}
+	return host, port
+}
+
+// Hierarchy modes define the shape of the asset tree.
+const (
+	HierarchyFlat  = "flat"  // people counters directly under the root
+	HierarchyGroup = "group" // people counters under their Xovis group
+	HierarchyTag   = "tag"   // people counters under the tag of the sensor
+)
+
 type Sensor struct {
 	ID       int64
 	Config   Configuration
@@ -34,11 +121,98 @@ type Sensor struct {
 	Hostname string
 	Port     int32
 
+	// Address the app connects to instead of hostname and port, e.g. the
+	// external address of a NAT or a port mapping. Nil to connect directly.
+	ConnectHostname *string
+	ConnectPort     *int32
+
 	DiscoveryMode string
 	L3FirstIP     *string
 	L3Count       *int32
 
 	MACAddress *string
+
+	// Overrides for the created assets, nil to use the group set on the device
+	// and the projects of the configuration.
+	Group      *string
+	ProjectIDs []string
+
+	// Existing Eliona asset the sensor's assets are placed under, nil to use
+	// the group mapping or the xovis group.
+	ParentAssetID *int32
+
+	// Groups the sensor's assets in hierarchy mode tag.
+	Tag *string
+
+	// Transforms the geometries of the sensor to the coordinates of a floor
+	// plan, nil to use the sensor's coordinates.
+	GeometryTransform AffineTransform
+
+	// Pinned fingerprint of the sensor's certificate, nil until the first
+	// contact. Only checked if the configuration does not check certificates.
+	CertFingerprint *string
+
+	// Result of the last collection, maintained by the app.
+	Status        string
+	StatusMessage *string
+	LastSeen      *time.Time
+}
+
+const (
+	SensorStatusUnknown     = "unknown"
+	SensorStatusOK          = "ok"
+	SensorStatusError       = "error"
+	SensorStatusUnsupported = "unsupported" // the sensor's firmware provides no supported API version
+	SensorStatusSecurity    = "security"    // the sensor's certificate does not match the pinned one
+)
+
+// SensorFilter selects sensors. Empty fields match all sensors.
+type SensorFilter struct {
+	ConfigID      int64
+	Hostname      string // substring, case-insensitive
+	MACAddress    string // case-insensitive
+	Status        string
+	DiscoveryMode string
+
+	// Sort is a column optionally prefixed by "-" for descending order.
+	Sort     string
+	Page     int // starting with 1, 0 for all sensors
+	PageSize int
+}
+
+// GroupMapping overrides where the assets of all sensors in a group are
+// created. Overrides set on the sensor take precedence.
+type GroupMapping struct {
+	ID            int64
+	ConfigID      int64
+	Group         string
+	ProjectIDs    []string
+	ParentAssetID *int32
+}
+
+// AssetProjectIDs returns the projects the sensor's assets are created in.
+// The mapping of the sensor's group may be nil.
+func (s Sensor) AssetProjectIDs(mapping *GroupMapping) []string {
+	if s.ProjectIDs != nil {
+		return s.ProjectIDs
+	}
+	if mapping != nil && mapping.ProjectIDs != nil {
+		return mapping.ProjectIDs
+	}
+	return s.Config.ProjectIDs
+}
+
+// AssetParentID returns the existing Eliona asset the sensor's assets are
+// placed under, or nil to place them in the xovis group. The mapping of the
+// sensor's group may be nil.
+func (s Sensor) AssetParentID(mapping *GroupMapping) *int32 {
+	if s.ParentAssetID != nil {
+		return s.ParentAssetID
+	}
+	if mapping != nil {
+		return mapping.ParentAssetID
+	}
+	return nil
 }
 
 type Asset struct {
@@ -49,3 +223,194 @@ type Asset struct {
 	ProviderID    string
 	AssetID       int32
 }
+
+// SensorHealth is the state of a sensor as reported by its status and live
+// pushes.
+type SensorHealth struct {
+	SerialNumber string
+	Online       *bool   // nil until reported
+	Illumination *string // e.g. OK or TOO_DARK, nil until reported
+	Tilted       bool
+	Covered      bool
+	UpdatedAt    time.Time
+}
+
+// Health levels summarize the health of a sensor.
+const (
+	HealthOK      = "ok"
+	HealthWarning = "warning" // the sensor counts, but maybe less accurately
+	HealthError   = "error"   // the sensor does not count correctly
+)
+
+const IlluminationOK = "OK"
+
+// Level returns how healthy the sensor is. An offline, covered or tilted
+// sensor does not count correctly, bad illumination reduces the accuracy.
+func (h SensorHealth) Level() string {
+	switch {
+	case h.Online != nil && !*h.Online, h.Covered, h.Tilted:
+		return HealthError
+	case h.Illumination != nil && *h.Illumination != IlluminationOK:
+		return HealthWarning
+	default:
+		return HealthOK
+	}
+}
+
+// Heatmap counts the positions of the tracked objects in a zone of a sensor
+// during a time window. The grid covers the bounding box of the zone, cells
+// are stored row by row starting at the origin.
+type Heatmap struct {
+	SerialNumber string
+	GeometryID   int32
+	GeometryName string
+	WindowStart  time.Time
+	WindowEnd    time.Time
+	OriginX      float64 // meters in the coordinates of the sensor
+	OriginY      float64
+	CellSize     float64 // meters
+	Columns      int32
+	Rows         int32
+	Cells        []int64
+	Frames       int32 // live frames the positions were counted in
+}
+
+// AffineTransform maps the point x, y to a*x + b*y + c, d*x + e*y + f, given
+// as {a, b, c, d, e, f}. A nil transform keeps the points as they are.
+type AffineTransform []float64
+
+// Apply returns the transformed point.
+func (t AffineTransform) Apply(x, y float64) (float64, float64) {
+	if len(t) != 6 {
+		return x, y
+	}
+	return t[0]*x + t[1]*y + t[2], t[3]*x + t[4]*y + t[5]
+}
+
+// Geometry is a line or zone of a logic of a sensor.
+type Geometry struct {
+	SerialNumber string
+	LogicID      int32
+	GeometryID   int32
+	Name         string
+	Type         string      // e.g. LINE or ZONE
+	Points       [][]float64 // x and y in meters, nil until reported by a live push
+	UpdatedAt    time.Time
+}
+
+// GeoJSON is a GeoJSON geometry object.
+type GeoJSON struct {
+	Type        string    `json:"type"`
+	Coordinates any       `json:"coordinates,omitempty"`
+	Geometries  []GeoJSON `json:"geometries,omitempty"`
+}
+
+// GeoJSON returns the geometry as a GeoJSON point, line string or polygon
+// with the transformed points, or nil if its points are not known. Zones are
+// closed polygons, other geometries of more than one point line strings.
+func (g Geometry) GeoJSON(transform AffineTransform) *GeoJSON {
+	var points [][]float64
+	for _, point := range g.Points {
+		if len(point) < 2 {
+			continue
+		}
+		x, y := transform.Apply(point[0], point[1])
+		points = append(points, []float64{x, y})
+	}
+	switch {
+	case len(points) == 0:
+		return nil
+	case len(points) == 1:
+		return &GeoJSON{Type: "Point", Coordinates: points[0]}
+	case strings.EqualFold(g.Type, GeometryZone) && len(points) >= 3:
+		if first, last := points[0], points[len(points)-1]; first[0] != last[0] || first[1] != last[1] {
+			points = append(points, first)
+		}
+		return &GeoJSON{Type: "Polygon", Coordinates: [][][]float64{points}}
+	default:
+		return &GeoJSON{Type: "LineString", Coordinates: points}
+	}
+}
+
+const GeometryZone = "ZONE"
+
+// LogicGeoJSON returns the geometries of a logic as a single GeoJSON
+// geometry, a geometry collection if there are several. It is nil if no
+// points are known.
+func LogicGeoJSON(geometries []Geometry, transform AffineTransform) *GeoJSON {
+	var collection []GeoJSON
+	for _, geometry := range geometries {
+		if geoJSON := geometry.GeoJSON(transform); geoJSON != nil {
+			collection = append(collection, *geoJSON)
+		}
+	}
+	switch len(collection) {
+	case 0:
+		return nil
+	case 1:
+		return &collection[0]
+	default:
+		return &GeoJSON{Type: "GeometryCollection", Geometries: collection}
+	}
+}
+
+// DatapushPackage identifies a live data package pushed by a sensor. Package
+// IDs start again after a restart of the sensor, so the first frame is part of
+// the identity.
+type DatapushPackage struct {
+	SerialNumber string
+	AgentID      int32
+	PackageID    int64
+	FirstFrame   int64
+}
+
+// Sources of datapush packages.
+const (
+	SourceWebhook = "webhook"
+	SourceMQTT    = "mqtt"
+)
+
+// QueuedDatapush is a received datapush package waiting to be processed. The
+// payload is the decoded package encoded as JSON again.
+type QueuedDatapush struct {
+	ID              int64
+	ConfigurationID int64
+	Source          string
+	SerialNumber    string
+	Payload         []byte
+	Attempts        int32
+	LastError       string
+	ReceivedAt      time.Time
+
+	// SideEffectsApplied tells that the data accumulated by the app, like the
+	// tracked objects, was counted by an earlier attempt.
+	SideEffectsApplied bool
+}
+
+// DeadLetter is a datapush package whose processing failed in all attempts.
+type DeadLetter struct {
+	QueuedDatapush
+	FailedAt time.Time
+}
+
+// ArchivedDatapush is a datapush body as received from a sensor.
+type ArchivedDatapush struct {
+	ID              int64
+	ConfigurationID int64
+	Source          string
+	SerialNumber    string
+	ContentType     string
+	Body            []byte
+	Size            int32
+	ReceivedAt      time.Time
+}
+
+// ArchiveFilter selects archived datapushes. Zero fields select all.
+type ArchiveFilter struct {
+	ConfigurationID int64
+	SerialNumber    string
+	From            time.Time
+	To              time.Time
+	IDs             []int64
+	Limit           int
+}
@@ -0,0 +1,55 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package confmodel
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAssetOverrides(t *testing.T) {
+	sensorParent, groupParent := int32(1), int32(2)
+
+	tests := []struct {
+		name         string
+		projectIDs   []string
+		parentID     *int32
+		mapping      *GroupMapping
+		wantProjects []string
+		wantParent   *int32
+	}{
+		{name: "configuration", wantProjects: []string{"10", "11"}},
+		{name: "group mapping without overrides", mapping: &GroupMapping{}, wantProjects: []string{"10", "11"}},
+		{name: "group mapping", mapping: &GroupMapping{ProjectIDs: []string{"20"}, ParentAssetID: &groupParent}, wantProjects: []string{"20"}, wantParent: &groupParent},
+		{name: "sensor", projectIDs: []string{"30"}, parentID: &sensorParent, wantProjects: []string{"30"}, wantParent: &sensorParent},
+		{name: "sensor before group mapping", projectIDs: []string{"30"}, parentID: &sensorParent,
+			mapping: &GroupMapping{ProjectIDs: []string{"20"}, ParentAssetID: &groupParent}, wantProjects: []string{"30"}, wantParent: &sensorParent},
+		{name: "no projects", projectIDs: []string{}, mapping: &GroupMapping{ProjectIDs: []string{"20"}}, wantProjects: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sensor := Sensor{ProjectIDs: tt.projectIDs, ParentAssetID: tt.parentID}
+			sensor.Config.ProjectIDs = []string{"10", "11"}
+
+			if got := sensor.AssetProjectIDs(tt.mapping); !slices.Equal(got, tt.wantProjects) {
+				t.Errorf("AssetProjectIDs() = %v, want %v", got, tt.wantProjects)
+			}
+			if got := sensor.AssetParentID(tt.mapping); got != tt.wantParent {
+				t.Errorf("AssetParentID() = %v, want %v", got, tt.wantParent)
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package mqtt receives the data pushed by the sensors through an MQTT broker,
+// for sites where the sensors cannot reach the app directly.
+package mqtt
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"xovis/broker"
+	"xovis/datapush"
+	"xovis/metrics"
+	confmodel "xovis/model/conf"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// Schemes of the supported broker URLs and their default ports. An empty port
+// leaves the port to the URL, as for websockets.
+var defaultBrokerPorts = map[string]string{
+	"tcp":   "1883",
+	"mqtt":  "1883",
+	"ssl":   "8883",
+	"tls":   "8883",
+	"mqtts": "8883",
+	"ws":    "",
+	"wss":   "",
+}
+
+var secureSchemes = map[string]bool{
+	"ssl":   true,
+	"tls":   true,
+	"mqtts": true,
+	"wss":   true,
+}
+
+const (
+	connectTimeout       = 30 * time.Second
+	keepAlive            = 30 * time.Second
+	maxReconnectInterval = time.Minute
+
+	// disconnectQuiesce is the time in milliseconds for finishing pending work
+	// when disconnecting.
+	disconnectQuiesce = 250
+
+	// Delays between the attempts of queueing a message.
+	minProcessRetryDelay = time.Second
+	maxProcessRetryDelay = 30 * time.Second
+
+	// qos 1 makes the broker redeliver messages not acknowledged when the
+	// session is resumed by the next connection.
+	qos = 1
+)
+
+// ParseBrokerURL parses the MQTT broker of a configuration. Supported are plain
+// (tcp, mqtt), TLS (ssl, tls, mqtts) and websocket (ws, wss) connections.
+func ParseBrokerURL(rawURL string) (*url.URL, error) {
+	brokerURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	defaultPort, ok := defaultBrokerPorts[brokerURL.Scheme]
+	if !ok {
+		return nil, fmt.Errorf("unsupported broker scheme %q, use tcp, mqtt, ssl, tls, mqtts, ws or wss", brokerURL.Scheme)
+	}
+	if brokerURL.Hostname() == "" {
+		return nil, fmt.Errorf("broker host missing in %q", rawURL)
+	}
+	if brokerURL.User != nil {
+		return nil, errors.New("credentials must be set as MQTT user name and password, not in the URL")
+	}
+	if brokerURL.Port() == "" && defaultPort != "" {
+		brokerURL.Host = net.JoinHostPort(brokerURL.Hostname(), defaultPort)
+	}
+	return brokerURL, nil
+}
+
+// ValidateTopic checks that the topic is a valid MQTT topic filter.
+func ValidateTopic(topic string) error {
+	if topic == "" {
+		return errors.New("topic must not be empty")
+	}
+	levels := strings.Split(topic, "/")
+	for i, level := range levels {
+		switch {
+		case level == "#" && i != len(levels)-1:
+			return fmt.Errorf("%q: wildcard # is only allowed as the last level", topic)
+		case level != "#" && strings.Contains(level, "#"):
+			return fmt.Errorf("%q: wildcard # must occupy a whole level", topic)
+		case level != "+" && strings.Contains(level, "+"):
+			return fmt.Errorf("%q: wildcard + must occupy a whole level", topic)
+		}
+	}
+	return nil
+}
+
+// Subscribe connects to the MQTT broker of the configuration and queues the
+// datapushes published to its topics until the context is done. It fails if
+// the first connection fails. Later connection losses are handled by
+// reconnecting and subscribing again.
+func Subscribe(ctx context.Context, config confmodel.Configuration) error {
+	return subscribe(ctx, config,
+		func(ctx context.Context, payload []byte, data datapush.Data) {
+			datapush.Archive(ctx, confmodel.SourceMQTT, config.ID, "", payload, data)
+		},
+		func(ctx context.Context, data datapush.Data) error {
+			return datapush.Enqueue(ctx, confmodel.SourceMQTT, config.ID, data)
+		},
+	)
+}
+
+type subscriber struct {
+	ctx      context.Context
+	configID string
+	topics   []string
+	archive  func(ctx context.Context, payload []byte, data datapush.Data)
+	enqueue  func(ctx context.Context, data datapush.Data) error
+
+	mu       sync.Mutex
+	closing  bool
+	inFlight sync.WaitGroup
+}
+
+// subscribe is Subscribe with the datapushes archived by archive, once per
+// message, and queued by enqueue, which is retried until it succeeds.
+func subscribe(ctx context.Context, config confmodel.Configuration, archive func(ctx context.Context, payload []byte, data datapush.Data), enqueue func(ctx context.Context, data datapush.Data) error) error {
+	s := &subscriber{
+		ctx:      ctx,
+		configID: strconv.FormatInt(config.ID, 10),
+		topics:   config.MQTTTopics,
+		archive:  archive,
+		enqueue:  enqueue,
+	}
+	opts, err := s.clientOptions(config)
+	if err != nil {
+		log.Error("mqtt", "Config %d: %v", config.ID, err)
+		return err
+	}
+
+	client := paho.NewClient(opts)
+	token := client.Connect()
+	if !token.WaitTimeout(connectTimeout) {
+		client.Disconnect(0)
+		err = fmt.Errorf("connecting to MQTT broker %s: timeout after %v", opts.Servers[0].Redacted(), connectTimeout)
+	} else if token.Error() != nil {
+		err = fmt.Errorf("connecting to MQTT broker %s: %w", opts.Servers[0].Redacted(), token.Error())
+	}
+	if err != nil {
+		log.Error("mqtt", "Config %d: %v", config.ID, err)
+		return err
+	}
+
+	<-ctx.Done()
+
+	// Refuse new messages first. Messages not acknowledged are redelivered by
+	// the broker when the session is resumed with the same client ID.
+	s.mu.Lock()
+	s.closing = true
+	s.mu.Unlock()
+	client.Disconnect(disconnectQuiesce)
+	s.inFlight.Wait()
+	metrics.MQTTConnected.WithLabelValues(s.configID).Set(0)
+	log.Info("mqtt", "Config %d: disconnected from MQTT broker.", config.ID)
+	return nil
+}
+
+func (s *subscriber) clientOptions(config confmodel.Configuration) (*paho.ClientOptions, error) {
+	if config.MQTTBrokerURL == nil {
+		return nil, errors.New("no MQTT broker configured")
+	}
+	brokerURL, err := ParseBrokerURL(*config.MQTTBrokerURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MQTT broker: %w", err)
+	}
+	if len(config.MQTTTopics) == 0 {
+		return nil, errors.New("no MQTT topics configured")
+	}
+	opts := paho.NewClientOptions().
+		AddBroker(brokerURL.String()).
+		SetClientID(clientID(config.ID)).
+		SetCleanSession(false).
+		SetAutoAckDisabled(true).
+		SetKeepAlive(keepAlive).
+		SetConnectTimeout(connectTimeout).
+		SetAutoReconnect(true).
+		SetMaxReconnectInterval(maxReconnectInterval).
+		SetOnConnectHandler(s.onConnect).
+		SetConnectionLostHandler(s.onConnectionLost).
+		SetReconnectingHandler(s.onReconnecting)
+	if config.MQTTUsername != nil && *config.MQTTUsername != "" {
+		opts.SetUsername(*config.MQTTUsername)
+	}
+	if config.MQTTPassword != nil && *config.MQTTPassword != "" {
+		opts.SetPassword(*config.MQTTPassword)
+	}
+	if secureSchemes[brokerURL.Scheme] {
+		rootCAs, err := broker.CertPool(config.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA bundle: %w", err)
+		}
+		opts.SetTLSConfig(&tls.Config{
+			ServerName: brokerURL.Hostname(),
+			RootCAs:    rootCAs,
+		})
+	}
+	return opts, nil
+}
+
+// clientID identifies the session at the broker, which keeps the messages not
+// acknowledged while the app is disconnected. Several instances of the app
+// subscribing to the same broker need a different MQTT_INSTANCE_ID each, so
+// that they do not disconnect each other.
+func clientID(configID int64) string {
+	if instance := common.Getenv("MQTT_INSTANCE_ID", ""); instance != "" {
+		return fmt.Sprintf("xovis-%d-%s", configID, instance)
+	}
+	return fmt.Sprintf("xovis-%d", configID)
+}
+
+// onConnect subscribes to the topics after every connect, because the broker
+// may have dropped the session, e.g. when it was restarted or the session
+// expired.
+func (s *subscriber) onConnect(client paho.Client) {
+	log.Info("mqtt", "Config %s: connected to MQTT broker, subscribing to %v.", s.configID, s.topics)
+	metrics.MQTTConnected.WithLabelValues(s.configID).Set(1)
+
+	filters := make(map[string]byte, len(s.topics))
+	for _, topic := range s.topics {
+		filters[topic] = qos
+	}
+	token := client.SubscribeMultiple(filters, s.handleMessage)
+	if !token.WaitTimeout(connectTimeout) {
+		log.Error("mqtt", "Config %s: subscribing to %v: timeout after %v", s.configID, s.topics, connectTimeout)
+		return
+	}
+	if err := token.Error(); err != nil {
+		log.Error("mqtt", "Config %s: subscribing to %v: %v", s.configID, s.topics, err)
+		return
+	}
+	if subscribeToken, ok := token.(*paho.SubscribeToken); ok {
+		for topic, code := range subscribeToken.Result() {
+			if code == 0x80 {
+				log.Error("mqtt", "Config %s: broker refused the subscription to %q", s.configID, topic)
+			}
+		}
+	}
+}
+
+func (s *subscriber) onConnectionLost(_ paho.Client, err error) {
+	log.Warn("mqtt", "Config %s: connection to MQTT broker lost: %v", s.configID, err)
+	metrics.MQTTConnected.WithLabelValues(s.configID).Set(0)
+}
+
+func (s *subscriber) onReconnecting(_ paho.Client, _ *paho.ClientOptions) {
+	log.Debug("mqtt", "Config %s: reconnecting to MQTT broker.", s.configID)
+}
+
+func (s *subscriber) handleMessage(_ paho.Client, msg paho.Message) {
+	s.mu.Lock()
+	if s.closing {
+		s.mu.Unlock()
+		return
+	}
+	s.inFlight.Add(1)
+	s.mu.Unlock()
+	defer s.inFlight.Done()
+
+	log.Debug("mqtt", "Config %s: received message on topic %s", s.configID, msg.Topic())
+	data, err := datapush.Decode("", msg.Payload())
+	if err != nil {
+		// Receiving it again would fail the same way.
+		log.Warn("mqtt", "Config %s: invalid datapush on topic %s: %v", s.configID, msg.Topic(), err)
+		metrics.MQTTMessages.WithLabelValues(s.configID, metrics.MQTTInvalid).Inc()
+		msg.Ack()
+		return
+	}
+	s.archive(s.ctx, msg.Payload(), data)
+	// The messages are delivered one after the other, so the next ones wait
+	// as well. They would fail alike while the queue cannot be written.
+	for delay := minProcessRetryDelay; ; delay = min(2*delay, maxProcessRetryDelay) {
+		err := s.enqueue(s.ctx, data)
+		if err == nil {
+			break
+		}
+		log.Error("mqtt", "Config %s: queueing datapush from topic %s, retrying in %v: %v", s.configID, msg.Topic(), delay, err)
+		metrics.MQTTMessages.WithLabelValues(s.configID, metrics.MQTTFailed).Inc()
+		select {
+		case <-s.ctx.Done():
+			// Not acknowledged, so the broker delivers it again when the
+			// session is resumed.
+			return
+		case <-time.After(delay):
+		}
+	}
+	msg.Ack()
+	metrics.MQTTMessages.WithLabelValues(s.configID, metrics.MQTTProcessed).Inc()
+}
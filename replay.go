@@ -0,0 +1,96 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+	"xovis/conf"
+	"xovis/datapush"
+	confmodel "xovis/model/conf"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// replayCommand replays archived datapushes against a configuration, e.g. to
+// reproduce an issue with a test configuration:
+//
+//	/app replay -config 2 -serial 80:1F:12:D3:4C:5A -from 2026-10-01T08:00:00Z -dry-run
+//
+// The result is printed as JSON. It returns the exit code of the app.
+func replayCommand(args []string) int {
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	configID := flags.Int64("config", 0, "ID of the configuration whose assets the counts are written to (required)")
+	serialNumber := flags.String("serial", "", "only the packages of the sensor with this serial number")
+	from := flags.String("from", "", "only the packages received at or after this time (RFC 3339)")
+	to := flags.String("to", "", "only the packages received before this time (RFC 3339)")
+	dryRun := flags.Bool("dry-run", false, "print the data instead of writing it to the assets")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *configID == 0 {
+		fmt.Fprintln(os.Stderr, "-config is required")
+		flags.Usage()
+		return 2
+	}
+
+	filter := confmodel.ArchiveFilter{SerialNumber: *serialNumber}
+	for _, bound := range []struct {
+		name  string
+		value string
+		time  *time.Time
+	}{
+		{"from", *from, &filter.From},
+		{"to", *to, &filter.To},
+	} {
+		if bound.value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, bound.value)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid -%s: %v\n", bound.name, err)
+			return 2
+		}
+		*bound.time = parsed
+	}
+
+	ctx := context.Background()
+	config, err := conf.GetConfig(ctx, *configID)
+	if err != nil {
+		log.Error("replay", "Reading config %d: %v", *configID, err)
+		return 1
+	}
+	result, err := datapush.ReplayArchive(ctx, config, filter, *dryRun)
+	if err != nil {
+		log.Error("replay", "Replaying datapushes: %v", err)
+		return 1
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		log.Error("replay", "Printing result: %v", err)
+		return 1
+	}
+	if result.Failed > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,187 @@
+//  This file is part of the Eliona project.
+//  Copyright © 2026 IoTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+	"xovis/metrics"
+	confmodel "xovis/model/conf"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// Task is a job which runs periodically for each enabled configuration.
+type Task struct {
+	Name     string
+	Interval func(config confmodel.Configuration) time.Duration
+	Run      func(ctx context.Context, config confmodel.Configuration) error
+}
+
+// Scheduler keeps one worker per enabled configuration. Each worker runs all
+// tasks with the settings the configuration had when the worker was started.
+type Scheduler struct {
+	tasks []Task
+
+	mu      sync.Mutex
+	workers map[int64]*worker
+}
+
+type worker struct {
+	config  confmodel.Configuration
+	cancel  context.CancelFunc
+	done    chan struct{}
+	started time.Time
+
+	mu          sync.Mutex
+	lastSuccess map[string]time.Time
+}
+
+func New(tasks ...Task) *Scheduler {
+	return &Scheduler{
+		tasks:   tasks,
+		workers: map[int64]*worker{},
+	}
+}
+
+// Reconcile brings the running workers in line with the given configurations.
+// Workers of new enabled configurations are started, workers whose configuration
+// settings differ or which are listed in changed are restarted, and workers of
+// disabled or deleted configurations are stopped. Running tasks of stopped
+// workers are interrupted through their context.
+func (s *Scheduler) Reconcile(ctx context.Context, configs []confmodel.Configuration, changed map[int64]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wanted := make(map[int64]confmodel.Configuration)
+	for _, config := range configs {
+		if config.Enable {
+			wanted[config.ID] = config
+		}
+	}
+
+	for id, w := range s.workers {
+		config, ok := wanted[id]
+		if ok && !changed[id] && sameSettings(w.config, config) {
+			continue
+		}
+		if ok {
+			log.Info("scheduler", "Restarting workers of config %d with changed settings.", id)
+		} else {
+			log.Info("scheduler", "Stopping workers of config %d.", id)
+		}
+		w.stop()
+		delete(s.workers, id)
+	}
+
+	for id, config := range wanted {
+		if _, ok := s.workers[id]; ok {
+			continue
+		}
+		s.workers[id] = s.start(ctx, config)
+	}
+}
+
+// LastSuccess returns when the task last finished without error for the
+// configuration. Until then, it returns when the worker was started. The result
+// is false if no worker runs for the configuration.
+func (s *Scheduler) LastSuccess(configID int64, task string) (time.Time, bool) {
+	s.mu.Lock()
+	w, ok := s.workers[configID]
+	s.mu.Unlock()
+	if !ok {
+		return time.Time{}, false
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if t, ok := w.lastSuccess[task]; ok {
+		return t, true
+	}
+	return w.started, true
+}
+
+// Stop interrupts all workers and waits until they are finished.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, w := range s.workers {
+		w.stop()
+		delete(s.workers, id)
+	}
+}
+
+func (s *Scheduler) start(ctx context.Context, config confmodel.Configuration) *worker {
+	ctx, cancel := context.WithCancel(ctx)
+	w := &worker{
+		config:      config,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+		started:     time.Now(),
+		lastSuccess: map[string]time.Time{},
+	}
+
+	var wg sync.WaitGroup
+	for _, task := range s.tasks {
+		wg.Add(1)
+		go func(task Task) {
+			defer wg.Done()
+			w.runTask(ctx, task)
+		}(task)
+	}
+	go func() {
+		wg.Wait()
+		close(w.done)
+	}()
+	return w
+}
+
+func (w *worker) stop() {
+	w.cancel()
+	<-w.done
+}
+
+func (w *worker) runTask(ctx context.Context, task Task) {
+	config := w.config
+	for {
+		start := time.Now()
+		err := task.Run(ctx, config)
+		metrics.TaskDuration.WithLabelValues(strconv.FormatInt(config.ID, 10), task.Name).Observe(time.Since(start).Seconds())
+		if err != nil {
+			log.Debug("scheduler", "Task %s of config %d failed: %v", task.Name, config.ID, err)
+		} else {
+			w.mu.Lock()
+			w.lastSuccess[task.Name] = time.Now()
+			w.mu.Unlock()
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(task.Interval(config)):
+		}
+	}
+}
+
+// sameSettings reports whether a worker started with config a can keep running
+// with config b. Runtime state like the active flag is not a setting.
+func sameSettings(a, b confmodel.Configuration) bool {
+	a.Active = b.Active
+	return reflect.DeepEqual(a, b)
+}
@@ -16,24 +16,38 @@
 package webhook
 
 import (
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
-	"xovis/conf"
-	"xovis/eliona"
+	"sync"
+	"xovis/datapush"
+	"xovis/metrics"
+	confmodel "xovis/model/conf"
 
 	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
-func NewWebhookHandler() http.Handler {
+// Handler receives datapush requests from the sensors.
+type Handler interface {
+	http.Handler
+
+	// Shutdown stops accepting new datapush requests and waits until the ones
+	// in flight are processed or the context is done.
+	Shutdown(ctx context.Context) error
+}
+
+func NewWebhookHandler() Handler {
 	return newWebhookServer()
 }
 
 type webhookServer struct {
 	mux *http.ServeMux
+
+	mu       sync.Mutex
+	closing  bool
+	inFlight sync.WaitGroup
 }
 
 func newWebhookServer() *webhookServer {
@@ -49,9 +63,23 @@ func newWebhookServer() *webhookServer {
 func (s *webhookServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	log.Debug("webhook", "Received request for URL: %s, Method: %s", r.URL.Path, r.Method)
 
+	s.mu.Lock()
+	if s.closing {
+		s.mu.Unlock()
+		// The sensors retry failed pushes, so another instance will receive the data.
+		w.Header().Set("Retry-After", "5")
+		http.Error(w, "Shutting down", http.StatusServiceUnavailable)
+		metrics.DatapushRequests.WithLabelValues(strconv.Itoa(http.StatusServiceUnavailable)).Inc()
+		return
+	}
+	s.inFlight.Add(1)
+	s.mu.Unlock()
+	defer s.inFlight.Done()
+
 	// Use a custom ResponseWriter to capture all status codes
 	lrw := &loggingResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
 	s.mux.ServeHTTP(lrw, r)
+	metrics.DatapushRequests.WithLabelValues(strconv.Itoa(lrw.statusCode)).Inc()
 
 	// Log all errors (non-2xx status codes)
 	if lrw.statusCode >= 400 {
@@ -59,6 +87,24 @@ func (s *webhookServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (s *webhookServer) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	s.closing = true
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.inFlight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for in-flight datapush requests: %v", ctx.Err())
+	}
+}
+
 // loggingResponseWriter is a wrapper for http.ResponseWriter to capture the status code.
 type loggingResponseWriter struct {
 	http.ResponseWriter
@@ -70,64 +116,6 @@ func (lrw *loggingResponseWriter) WriteHeader(code int) {
 	lrw.ResponseWriter.WriteHeader(code)
 }
 
-type WebhookData struct {
-	LiveData struct {
-		PackageInfo struct {
-			Version string `json:"version"`
-			ID      int    `json:"id"`
-			AgentID int    `json:"agent_id"`
-		} `json:"package_info"`
-		SensorInfo struct {
-			SerialNumber string `json:"serial_number"`
-			Type         string `json:"type"`
-		} `json:"sensor_info"`
-		Config struct {
-			Logics []struct {
-				ID           int    `json:"id"`
-				Name         string `json:"name"`
-				OptionalData string `json:"optional_data"`
-				Geometries   []int  `json:"geometries"`
-			} `json:"logics"`
-			Counts []struct {
-				ID      int    `json:"id"`
-				Name    string `json:"name"`
-				LogicID int    `json:"logic_id"`
-				Type    string `json:"type"`
-			} `json:"counts"`
-			Geometries []struct {
-				ID       int         `json:"id"`
-				Name     string      `json:"name"`
-				Type     string      `json:"type"`
-				Geometry [][]float64 `json:"geometry"`
-			} `json:"geometries"`
-		} `json:"config"`
-		Frames []struct {
-			FrameNumber    int    `json:"framenumber"`
-			FrameType      string `json:"frametype"`
-			Time           int64  `json:"time"`
-			Illumination   string `json:"illumination"`
-			TrackedObjects []struct {
-				TrackID    int       `json:"track_id"`
-				Type       string    `json:"type"`
-				Position   []float64 `json:"position"`
-				Attributes struct {
-					PersonHeight float64 `json:"person_height"`
-					Members      int     `json:"members"`
-				} `json:"attributes"`
-			} `json:"tracked_objects"`
-			Events []struct {
-				Category   string `json:"category"`
-				Type       string `json:"type"`
-				Attributes struct {
-					CounterID    int `json:"counter_id"`
-					CounterValue int `json:"counter_value"`
-					TrackID      int `json:"track_id"`
-				} `json:"attributes"`
-			} `json:"events"`
-		} `json:"frames"`
-	} `json:"live_data"`
-}
-
 func (s *webhookServer) handleDatapush(w http.ResponseWriter, r *http.Request) {
 	configIDStr := r.PathValue("configID")
 	configID, err := strconv.ParseInt(configIDStr, 10, 64)
@@ -136,7 +124,6 @@ func (s *webhookServer) handleDatapush(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Invalid config ID", http.StatusBadRequest)
 		return
 	}
-	_ = configID // TODO: Do we need it, actually?
 
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -146,63 +133,21 @@ func (s *webhookServer) handleDatapush(w http.ResponseWriter, r *http.Request) {
 	}
 	defer r.Body.Close()
 
-	log.Trace("datapush", "raw datapush:\n%s\n", string(body))
-
-	var data WebhookData
-	if err := json.Unmarshal(body, &data); err != nil {
+	contentType := r.Header.Get("Content-Type")
+	data, err := datapush.Decode(contentType, body)
+	if err != nil {
 		log.Warn("webhook", "Failed to parse request body: %v\nRequest: %v", err, string(body))
-		http.Error(w, "Failed to parse request body", http.StatusInternalServerError)
+		// Pushing the package again would fail the same way.
+		http.Error(w, "Failed to parse request body", http.StatusBadRequest)
 		return
 	}
-
-	for _, frame := range data.LiveData.Frames {
-		for _, event := range frame.Events {
-			switch event.Category {
-			case "COUNT":
-				counterValue := event.Attributes.CounterValue
-				rawCounterID := event.Attributes.CounterID
-				logicID := rawCounterID / 1000   // Get the first part (e.g., 1008 from 1008001)
-				counterID := rawCounterID % 1000 // Get the last part (e.g., 001 from 1008001)
-
-				dataToUpsert := map[string]any{"presence": counterValue}
-				gai := fmt.Sprintf("xovis_zone_%v_%v", data.LiveData.SensorInfo.SerialNumber, logicID)
-				asset, err := conf.GetAssetByGAI(gai)
-
-				// Looks like there is no better way now to distinguish lines and zones...
-				if errors.Is(err, conf.ErrNotFound) {
-					gai = fmt.Sprintf("xovis_line_%v_%v", data.LiveData.SensorInfo.SerialNumber, logicID)
-					asset, err = conf.GetAssetByGAI(gai)
-					if err != nil {
-						log.Error("datapush", "getting asset by GAI %s: %v", gai, err)
-						err = nil
-						continue
-					}
-
-					// Determine the key based on counterID (001 -> "forward", 002 -> "backward")
-					var key string
-					switch counterID {
-					case 1:
-						key = "forward"
-					case 2:
-						key = "backward"
-					default:
-						log.Warn("datapush", "unknown counter ID %v for logic %v, skipping", counterID, logicID)
-						continue
-					}
-
-					dataToUpsert = map[string]any{key: counterValue}
-				}
-				if err != nil {
-					log.Error("datapush", "getting asset by GAI %s: %v", gai, err)
-					continue
-				}
-				if err := eliona.UpsertAssetData(asset.Config, asset.AssetID, dataToUpsert); err != nil {
-					log.Error("datapush", "upserting data: %v", err)
-					continue
-				}
-				log.Debug("datapush", "set %v data %+v", asset.AssetID, dataToUpsert)
-			}
-		}
+	datapush.Archive(r.Context(), confmodel.SourceWebhook, configID, contentType, body, data)
+	if err := datapush.Enqueue(r.Context(), confmodel.SourceWebhook, configID, data); err != nil {
+		log.Error("webhook", "Failed to queue datapush: %v", err)
+		// The sensor pushes the package again.
+		w.Header().Set("Retry-After", "5")
+		http.Error(w, "Failed to queue datapush", http.StatusServiceUnavailable)
+		return
 	}
 
 	w.WriteHeader(http.StatusOK)